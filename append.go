@@ -0,0 +1,376 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/bits"
+)
+
+// AppendHex8 appends the hexadecimal representation of an uint8 value to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint8 value to convert.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHex8(dst []byte, value uint8) []byte {
+	return AppendHex8Formatted(dst, value, 0)
+}
+
+// AppendHex16 appends the hexadecimal representation of an uint16 value to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint16 value to convert.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHex16(dst []byte, value uint16) []byte {
+	return AppendHex16Formatted(dst, value, 0)
+}
+
+// AppendHex32 appends the hexadecimal representation of an uint32 value to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint32 value to convert.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHex32(dst []byte, value uint32) []byte {
+	return AppendHex32Formatted(dst, value, 0)
+}
+
+// AppendHex64 appends the hexadecimal representation of an uint64 value to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint64 value to convert.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHex64(dst []byte, value uint64) []byte {
+	return AppendHex64Formatted(dst, value, 0)
+}
+
+// AppendUintDecimal appends the decimal representation of an uint64 value to the given byte slice
+//
+// The digits are produced two at a time from decimalDigitPairs, dividing by reciprocal multiplication. Values that fit
+// in an uint32 only use 32-bit arithmetic, and larger values are split into uint32 chunks of eight digits first, so no
+// 64-bit division routine is called on 32-bit cores.
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint64 value to convert.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendUintDecimal(dst []byte, value uint64) []byte {
+	// Fill the scratch buffer from the end
+	var buffer [UintToDecimalBufferSize]byte
+	i := len(buffer)
+
+	// Split off eight digits at a time until the value fits in an uint32
+	for value > math.MaxUint32 {
+		hi, _ := bits.Mul64(value>>8, decimalChunkReciprocal)
+		quotient := hi >> decimalChunkShift
+		i = putUint32Decimal(buffer[:i], uint32(value-quotient*decimalChunk), decimalChunkDigits)
+		value = quotient
+	}
+	i = putUint32Decimal(buffer[:i], uint32(value), 1)
+	return append(dst, buffer[i:]...)
+}
+
+// putUint32Decimal writes the decimal digits of an uint32 value at the end of the given buffer
+//
+// Parameters:
+//
+//	buffer: The buffer to write to, which must have room for the digits and the padding.
+//	value: The uint32 value to convert.
+//	width: The minimum number of digits, padded with leading zeros.
+//
+// Returns:
+//
+// The index of the first written digit.
+func putUint32Decimal(buffer []byte, value uint32, width int) int {
+	i := len(buffer)
+	for value >= 100 {
+		quotient := uint32(uint64(value) * decimalPairReciprocal >> 37)
+		pair := (value - quotient*100) * 2
+		i -= 2
+		buffer[i] = decimalDigitPairs[pair]
+		buffer[i+1] = decimalDigitPairs[pair+1]
+		value = quotient
+	}
+
+	// Write the last one or two digits
+	if value >= 10 {
+		pair := value * 2
+		i -= 2
+		buffer[i] = decimalDigitPairs[pair]
+		buffer[i+1] = decimalDigitPairs[pair+1]
+	} else {
+		i--
+		buffer[i] = ASCIIDecimalDigits[value]
+	}
+
+	// Pad with leading zeros
+	for len(buffer)-i < width {
+		i--
+		buffer[i] = ASCIIDecimalDigits[0]
+	}
+	return i
+}
+
+// AppendIntDecimal appends the decimal representation of an int64 value to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The int64 value to convert.
+//
+// Returns:
+//
+// The extended byte slice, including a minus sign if the value is negative. No allocation is made if dst has enough
+// capacity.
+func AppendIntDecimal(dst []byte, value int64) []byte {
+	if value < 0 {
+		dst = append(dst, '-')
+
+		// Negate in unsigned arithmetic so math.MinInt64 is handled correctly
+		return AppendUintDecimal(dst, -uint64(value))
+	}
+	return AppendUintDecimal(dst, uint64(value))
+}
+
+// AppendUintDecimalFixed appends the decimal representation of an uint64 value with fixed width to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint64 value to convert.
+//	width: The fixed width for the decimal representation.
+//
+// Returns:
+//
+// The extended byte slice, with leading zeros if necessary. No allocation is made if dst has enough capacity.
+func AppendUintDecimalFixed(dst []byte, value uint64, width int) []byte {
+	start := len(dst)
+	dst = AppendUintDecimal(dst, value)
+	pad := width - (len(dst) - start)
+
+	// Check if padding is needed
+	if pad <= 0 {
+		return dst
+	}
+
+	// Grow the slice, move existing digits to the right and prepend leading zeros
+	for i := 0; i < pad; i++ {
+		dst = append(dst, ASCIIDecimalDigits[0])
+	}
+	copy(dst[start+pad:], dst[start:len(dst)-pad])
+	for i := start; i < start+pad; i++ {
+		dst[i] = ASCIIDecimalDigits[0]
+	}
+	return dst
+}
+
+// AppendFloat64 appends the decimal representation of a float64 value with specified precision to the given byte slice,
+// rounding half to even
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// The extended byte slice. NaN and infinities are appended as NaNBuffer, PositiveInfBuffer and NegativeInfBuffer. No
+// allocation is made if dst has enough capacity.
+func AppendFloat64(dst []byte, value float64, precision int) []byte {
+	return AppendFloat64Rounded(dst, value, precision, RoundHalfEven)
+}
+
+// AppendFloat64Rounded appends the decimal representation of a float64 value with specified precision and rounding mode
+// to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// The extended byte slice. NaN and infinities are appended as NaNBuffer, PositiveInfBuffer and NegativeInfBuffer. No
+// allocation is made if dst has enough capacity.
+func AppendFloat64Rounded(dst []byte, value float64, precision int, mode RoundingMode) []byte {
+	if float64FixedPointDefault {
+		return AppendFloat64RoundedFixedPoint(dst, value, precision, mode)
+	}
+	return appendFloat64RoundedFloat(dst, value, precision, mode)
+}
+
+// appendFloat64RoundedFloat appends the decimal representation of a float64 value with specified precision and
+// rounding mode, splitting the value with floating point arithmetic and computing the fractional digits exactly in
+// fixed point
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// The extended byte slice.
+func appendFloat64RoundedFloat(dst []byte, value float64, precision int, mode RoundingMode) []byte {
+	// Check for the special values
+	if special, ok := appendFloat64Special(dst, value); ok {
+		return special
+	}
+
+	// Split the value, the subtraction is exact and so is the scaling of the fractional part to 4.60 fixed point
+	// unless it has bits below 2^-60
+	abs := math.Abs(value)
+	var intPart, fixed uint64
+	if abs < float64Uint64Limit {
+		intPart = uint64(abs)
+		scaled := (abs - float64(intPart)) * (1 << float64FractionBits)
+		fixed = uint64(scaled)
+		if float64(fixed) != scaled {
+			// The smaller bits need the exact decimal expansion
+			return AppendFloat64RoundedFixedPoint(dst, value, precision, mode)
+		}
+	}
+
+	// Use the sign bit, so negative fractions and negative zero keep their sign
+	if math.Signbit(value) {
+		dst = append(dst, '-')
+	}
+	start := len(dst)
+
+	// Convert integer part and add dot, values beyond the uint64 range have no fractional part
+	if abs < float64Uint64Limit {
+		dst = AppendUintDecimal(dst, intPart)
+	} else {
+		dst = appendFloatInteger(dst, math.Float64bits(abs), &float64Info)
+	}
+	dst = append(dst, '.')
+	return mode.appendFractionDigits(dst, start, fixed, float64FractionBits, precision)
+}
+
+// appendFloat64Special appends the representation of NaN and infinities
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//
+// Returns:
+//
+// The extended byte slice and true if the value is NaN or an infinity, or dst and false otherwise.
+func appendFloat64Special(dst []byte, value float64) ([]byte, bool) {
+	switch {
+	case math.IsNaN(value):
+		return append(dst, NaNBuffer...), true
+	case math.IsInf(value, 1):
+		return append(dst, PositiveInfBuffer...), true
+	case math.IsInf(value, -1):
+		return append(dst, NegativeInfBuffer...), true
+	}
+	return dst, false
+}
+
+// appendFloatInteger appends the exact decimal digits of a large binary floating point number without fractional part
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	bits: The bits of the positive floating point number.
+//	flt: The layout of the floating point format.
+//
+// Returns:
+//
+// The extended byte slice.
+func appendFloatInteger(dst []byte, bits uint64, flt *floatInfo) []byte {
+	var digits [decimalFormatDigits + decimalShiftSlack]byte
+	d := decimal{d: digits[:]}
+	d.assignFloat(bits, flt)
+	dst = append(dst, d.d[:d.nd]...)
+	for i := d.nd; i < d.dp; i++ {
+		dst = append(dst, ASCIIDecimalDigits[0])
+	}
+	return dst
+}
+
+// float64DecimalSize returns the number of bytes AppendFloat64 appends for the given value and precision, without the
+// extra integer digit a rounding carry may add
+//
+// Parameters:
+//
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// The size in bytes of the decimal representation, or -1 if the integer part does not fit in an uint64.
+func float64DecimalSize(value float64, precision int) int {
+	bits := math.Float64bits(value)
+	if bits&float64ExponentMask == float64ExponentMask {
+		if bits&(1<<52-1) != 0 {
+			return len(NaNBuffer)
+		}
+		return len(PositiveInfBuffer)
+	}
+
+	size := 1
+	if bits>>63 != 0 {
+		size++
+	}
+	intPart, ok := float64IntegerPart(bits)
+	if !ok {
+		return -1
+	}
+	var buffer [UintToDecimalBufferSize]byte
+	size += len(AppendUintDecimal(buffer[:0], intPart))
+	if precision > 0 {
+		size += precision
+	}
+	return size
+}
+
+// float64IntegerPart returns the integer part of the absolute value of a finite float64 using integer arithmetic
+//
+// Parameters:
+//
+//	bits: The bits of the float64 value.
+//
+// Returns:
+//
+// The integer part and true if it fits in an uint64, or false otherwise.
+func float64IntegerPart(bits uint64) (uint64, bool) {
+	mant, exp := floatParts(bits, &float64Info)
+	switch shift := exp - int(float64Info.mantBits); {
+	case shift >= 64-int(float64Info.mantBits):
+		return 0, false
+	case shift >= 0:
+		return mant << shift, true
+	case shift > -64:
+		return mant >> -shift, true
+	}
+	return 0, true
+}
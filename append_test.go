@@ -0,0 +1,231 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// appendUintDecimalLoop is the digit loop AppendUintDecimal used before the reciprocal conversion, one 64-bit division
+// per digit, kept as the benchmark baseline
+func appendUintDecimalLoop(dst []byte, value uint64) []byte {
+	var buffer [UintToDecimalBufferSize]byte
+	i := len(buffer)
+	v := value
+	if v == 0 {
+		i--
+		buffer[i] = ASCIIDecimalDigits[0]
+	}
+	for v > 0 {
+		i--
+		buffer[i] = ASCIIDecimalDigits[v%10]
+		v /= 10
+	}
+	return append(dst, buffer[i:]...)
+}
+
+// decimalChunkQuotient divides by decimalChunk like AppendUintDecimal
+func decimalChunkQuotient(value uint64) uint64 {
+	hi, _ := bits.Mul64(value>>8, decimalChunkReciprocal)
+	return hi >> decimalChunkShift
+}
+
+// TestDecimalChunkReciprocal checks the reciprocal division by decimalChunk on both sides of the multiples of
+// decimalChunk at the bottom and the top of the uint64 range, where the error of the reciprocal is largest
+func TestDecimalChunkReciprocal(t *testing.T) {
+	const window = 1 << 20
+	check := func(value uint64) {
+		if got, want := decimalChunkQuotient(value), value/decimalChunk; got != want {
+			t.Fatalf("decimalChunkQuotient(%d) = %d, want %d", value, got, want)
+		}
+	}
+
+	last := uint64(math.MaxUint64) / decimalChunk
+	for k := uint64(1); k <= window; k++ {
+		for _, chunk := range []uint64{k, last - k + 1} {
+			check(chunk*decimalChunk - 1)
+			check(chunk * decimalChunk)
+		}
+	}
+	check(math.MaxUint64)
+	check(math.MaxUint64 - 1)
+	check(last * decimalChunk)
+	check(uint64(math.MaxUint32) + 1)
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < window; i++ {
+		chunk := rng.Uint64()/decimalChunk + 1
+		check(chunk*decimalChunk - 1)
+		check(chunk * decimalChunk)
+		check(rng.Uint64())
+	}
+}
+
+// TestDecimalPairReciprocal checks the reciprocal division by 100 on both sides of every multiple of 100 in the
+// uint32 range
+func TestDecimalPairReciprocal(t *testing.T) {
+	check := func(value uint32) {
+		if got, want := uint32(uint64(value)*decimalPairReciprocal>>37), value/100; got != want {
+			t.Fatalf("reciprocal quotient of %d = %d, want %d", value, got, want)
+		}
+	}
+	for k := uint32(1); k <= math.MaxUint32/100; k++ {
+		check(k*100 - 1)
+		check(k * 100)
+	}
+	check(math.MaxUint32)
+}
+
+// TestAppendUintDecimal compares with strconv around the chunk boundaries, the powers of ten and the limits
+func TestAppendUintDecimal(t *testing.T) {
+	check := func(value uint64) {
+		if got, want := string(AppendUintDecimal(nil, value)), strconv.FormatUint(value, 10); got != want {
+			t.Fatalf("AppendUintDecimal(%d) = %q, want %q", value, got, want)
+		}
+	}
+
+	values := []uint64{0, 1, 9, 10, 99, 100, math.MaxUint32 - 1, math.MaxUint32, math.MaxUint32 + 1, math.MaxUint64}
+	for pow := uint64(1); pow <= math.MaxUint64/10; pow *= 10 {
+		values = append(values, pow-1, pow, pow+1, pow*10-1)
+	}
+	for k := uint64(1); k <= 1000; k++ {
+		values = append(values, k*decimalChunk-1, k*decimalChunk, k*decimalChunk*decimalChunk-1)
+	}
+	for _, value := range values {
+		check(value)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		check(rng.Uint64() >> rng.Intn(64))
+		check(uint64(rng.Uint32()))
+	}
+
+	// Every uint32 in a stride that visits all the two-digit remainders
+	for value := uint64(0); value <= math.MaxUint32; value += 9973 {
+		check(value)
+	}
+}
+
+// TestAppendIntDecimal compares with strconv, including the minimum int64
+func TestAppendIntDecimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	values := []int64{0, -1, 1, math.MinInt64, math.MaxInt64, math.MinInt32, math.MaxInt32}
+	for i := 0; i < 10000; i++ {
+		values = append(values, int64(rng.Uint64())>>rng.Intn(64))
+	}
+	for _, value := range values {
+		if got, want := string(AppendIntDecimal(nil, value)), strconv.FormatInt(value, 10); got != want {
+			t.Fatalf("AppendIntDecimal(%d) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+// benchmarkDecimalValues returns values spread over the uint32 or the uint64 range
+func benchmarkDecimalValues(max uint64) []uint64 {
+	rng := rand.New(rand.NewSource(1))
+	values := make([]uint64, 1024)
+	for i := range values {
+		values[i] = rng.Uint64() % max
+	}
+	return values
+}
+
+// BenchmarkAppendUintDecimal compares the reciprocal conversion with the previous division loop, run it with
+// GOARCH=386 or GOARCH=arm to see the cost of the 64-bit division routine
+func BenchmarkAppendUintDecimal(b *testing.B) {
+	for _, bench := range []struct {
+		name   string
+		values []uint64
+	}{
+		{"Uint32", benchmarkDecimalValues(math.MaxUint32)},
+		{"Uint64", benchmarkDecimalValues(math.MaxUint64)},
+	} {
+		var buffer [UintToDecimalBufferSize]byte
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				AppendUintDecimal(buffer[:0], bench.values[i%len(bench.values)])
+			}
+		})
+		b.Run(bench.name+"Loop", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				appendUintDecimalLoop(buffer[:0], bench.values[i%len(bench.values)])
+			}
+		})
+	}
+}
+
+// float64RoundedTests are values whose binary expansion lies just below or above a decimal tie, the expected digits
+// are those of the exact binary value
+var float64RoundedTests = []struct {
+	value     float64
+	precision int
+	halfEven  string
+	halfUp    string
+	truncate  string
+}{
+	{1.865, 2, "1.86", "1.86", "1.86"},
+	{0.255, 2, "0.26", "0.26", "0.25"},
+	{1.575, 2, "1.57", "1.57", "1.57"},
+	{1.895, 2, "1.90", "1.90", "1.89"},
+	{0.245, 2, "0.24", "0.24", "0.24"},
+	{0.925, 2, "0.93", "0.93", "0.92"},
+	{9.995, 2, "9.99", "9.99", "9.99"},
+	{-0.375, 2, "-0.38", "-0.38", "-0.37"},
+	{0.125, 2, "0.12", "0.13", "0.12"},
+	{2.5, 0, "2.", "3.", "2."},
+	{9.5, 0, "10.", "10.", "9."},
+	{-0.001, 2, "-0.00", "-0.00", "-0.00"},
+	{1e-5, 3, "0.000", "0.000", "0.000"},
+	{0.1, 20, "0.10000000000000000555", "0.10000000000000000555", "0.10000000000000000555"},
+	{1e20, 1, "100000000000000000000.0", "100000000000000000000.0", "100000000000000000000.0"},
+}
+
+// TestAppendFloat64Rounded checks the digits of values near a decimal tie in every rounding mode
+func TestAppendFloat64Rounded(t *testing.T) {
+	for _, test := range float64RoundedTests {
+		for mode, want := range map[RoundingMode]string{
+			RoundHalfEven: test.halfEven,
+			RoundHalfUp:   test.halfUp,
+			RoundTruncate: test.truncate,
+		} {
+			if got := string(AppendFloat64Rounded(nil, test.value, test.precision, mode)); got != want {
+				t.Errorf("AppendFloat64Rounded(%v, %d, %d) = %q, want %q", test.value, test.precision, mode, got, want)
+			}
+		}
+	}
+}
+
+// TestAppendFloat64 compares random values with strconv, which also rounds the exact value half to even
+func TestAppendFloat64(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200000; i++ {
+		var value float64
+		switch i % 3 {
+		case 0:
+			// Values with few decimals, which land near decimal ties
+			value = float64(rng.Int63n(1000000)) / 1000
+		case 1:
+			value = rng.Float64() * math.Pow(10, float64(rng.Intn(30)-10))
+		default:
+			value = math.Float64frombits(rng.Uint64())
+			if math.IsNaN(value) || math.IsInf(value, 0) || math.Abs(value) >= 1e19 {
+				continue
+			}
+		}
+		if rng.Intn(2) == 0 {
+			value = -value
+		}
+		precision := rng.Intn(10)
+
+		want := strconv.FormatFloat(value, 'f', precision, 64)
+		if precision == 0 {
+			want += "."
+		}
+		if got := string(AppendFloat64(nil, value, precision)); got != want {
+			t.Fatalf("AppendFloat64(%v, %d) = %q, want %q", value, precision, got, want)
+		}
+	}
+}
@@ -0,0 +1,1548 @@
+package tinygo_buffers
+
+import (
+	"encoding/binary"
+	"unsafe"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// bulkUnroll is the number of elements converted per iteration by the bulk converters
+const bulkUnroll = 4
+
+// PutUint16s converts a slice of uint16 values to bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 2*len(src) bytes to store the resulting bytes.
+//	src: The uint16 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint16s(dst []byte, src []uint16) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*2 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint16sBE(dst, sliceBits[uint16](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint16s converts bytes in big-endian order to a slice of uint16 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint16 values.
+//	src: A byte slice containing at least 2*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint16s(dst []uint16, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*2 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint16sBE(sliceBits[uint16](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint16sLE converts a slice of uint16 values to bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 2*len(src) bytes to store the resulting bytes.
+//	src: The uint16 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint16sLE(dst []byte, src []uint16) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*2 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint16sLE(dst, sliceBits[uint16](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint16sLE converts bytes in little-endian order to a slice of uint16 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint16 values.
+//	src: A byte slice containing at least 2*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint16sLE(dst []uint16, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*2 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint16sLE(sliceBits[uint16](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt16s converts a slice of int16 values to bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 2*len(src) bytes to store the resulting bytes.
+//	src: The int16 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt16s(dst []byte, src []int16) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*2 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint16sBE(dst, sliceBits[uint16](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt16s converts bytes in big-endian order to a slice of int16 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int16 values.
+//	src: A byte slice containing at least 2*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt16s(dst []int16, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*2 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint16sBE(sliceBits[uint16](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt16sLE converts a slice of int16 values to bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 2*len(src) bytes to store the resulting bytes.
+//	src: The int16 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt16sLE(dst []byte, src []int16) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*2 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint16sLE(dst, sliceBits[uint16](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt16sLE converts bytes in little-endian order to a slice of int16 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int16 values.
+//	src: A byte slice containing at least 2*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt16sLE(dst []int16, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*2 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint16sLE(sliceBits[uint16](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint32s converts a slice of uint32 values to bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 4*len(src) bytes to store the resulting bytes.
+//	src: The uint32 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint32s(dst []byte, src []uint32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint32sBE(dst, sliceBits[uint32](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint32s converts bytes in big-endian order to a slice of uint32 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint32 values.
+//	src: A byte slice containing at least 4*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint32s(dst []uint32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint32sBE(sliceBits[uint32](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint32sLE converts a slice of uint32 values to bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 4*len(src) bytes to store the resulting bytes.
+//	src: The uint32 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint32sLE(dst []byte, src []uint32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint32sLE(dst, sliceBits[uint32](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint32sLE converts bytes in little-endian order to a slice of uint32 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint32 values.
+//	src: A byte slice containing at least 4*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint32sLE(dst []uint32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint32sLE(sliceBits[uint32](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt32s converts a slice of int32 values to bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 4*len(src) bytes to store the resulting bytes.
+//	src: The int32 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt32s(dst []byte, src []int32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint32sBE(dst, sliceBits[uint32](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt32s converts bytes in big-endian order to a slice of int32 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int32 values.
+//	src: A byte slice containing at least 4*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt32s(dst []int32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint32sBE(sliceBits[uint32](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt32sLE converts a slice of int32 values to bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 4*len(src) bytes to store the resulting bytes.
+//	src: The int32 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt32sLE(dst []byte, src []int32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint32sLE(dst, sliceBits[uint32](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt32sLE converts bytes in little-endian order to a slice of int32 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int32 values.
+//	src: A byte slice containing at least 4*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt32sLE(dst []int32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint32sLE(sliceBits[uint32](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutFloat32s converts a slice of float32 values to bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 4*len(src) bytes to store the resulting bytes.
+//	src: The float32 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutFloat32s(dst []byte, src []float32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint32sBE(dst, sliceBits[uint32](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetFloat32s converts bytes in big-endian order to a slice of float32 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting float32 values.
+//	src: A byte slice containing at least 4*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetFloat32s(dst []float32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint32sBE(sliceBits[uint32](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutFloat32sLE converts a slice of float32 values to bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 4*len(src) bytes to store the resulting bytes.
+//	src: The float32 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutFloat32sLE(dst []byte, src []float32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint32sLE(dst, sliceBits[uint32](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetFloat32sLE converts bytes in little-endian order to a slice of float32 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting float32 values.
+//	src: A byte slice containing at least 4*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetFloat32sLE(dst []float32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*4 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint32sLE(sliceBits[uint32](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint64s converts a slice of uint64 values to bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 8*len(src) bytes to store the resulting bytes.
+//	src: The uint64 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint64s(dst []byte, src []uint64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint64sBE(dst, sliceBits[uint64](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint64s converts bytes in big-endian order to a slice of uint64 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint64 values.
+//	src: A byte slice containing at least 8*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint64s(dst []uint64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint64sBE(sliceBits[uint64](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint64sLE converts a slice of uint64 values to bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 8*len(src) bytes to store the resulting bytes.
+//	src: The uint64 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint64sLE(dst []byte, src []uint64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint64sLE(dst, sliceBits[uint64](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint64sLE converts bytes in little-endian order to a slice of uint64 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint64 values.
+//	src: A byte slice containing at least 8*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint64sLE(dst []uint64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint64sLE(sliceBits[uint64](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt64s converts a slice of int64 values to bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 8*len(src) bytes to store the resulting bytes.
+//	src: The int64 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt64s(dst []byte, src []int64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint64sBE(dst, sliceBits[uint64](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt64s converts bytes in big-endian order to a slice of int64 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int64 values.
+//	src: A byte slice containing at least 8*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt64s(dst []int64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint64sBE(sliceBits[uint64](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt64sLE converts a slice of int64 values to bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 8*len(src) bytes to store the resulting bytes.
+//	src: The int64 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt64sLE(dst []byte, src []int64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint64sLE(dst, sliceBits[uint64](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt64sLE converts bytes in little-endian order to a slice of int64 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int64 values.
+//	src: A byte slice containing at least 8*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt64sLE(dst []int64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint64sLE(sliceBits[uint64](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutFloat64s converts a slice of float64 values to bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 8*len(src) bytes to store the resulting bytes.
+//	src: The float64 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutFloat64s(dst []byte, src []float64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint64sBE(dst, sliceBits[uint64](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetFloat64s converts bytes in big-endian order to a slice of float64 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting float64 values.
+//	src: A byte slice containing at least 8*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetFloat64s(dst []float64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint64sBE(sliceBits[uint64](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutFloat64sLE converts a slice of float64 values to bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 8*len(src) bytes to store the resulting bytes.
+//	src: The float64 values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutFloat64sLE(dst []byte, src []float64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint64sLE(dst, sliceBits[uint64](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetFloat64sLE converts bytes in little-endian order to a slice of float64 values, storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting float64 values.
+//	src: A byte slice containing at least 8*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetFloat64sLE(dst []float64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*8 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint64sLE(sliceBits[uint64](dst), src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint24s converts a slice of uint32 values to 24-bit values in big-endian order, storing the result in the
+// provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 3*len(src) bytes to store the resulting bytes.
+//	src: The uint32 values to convert, only their low 24 bits are stored.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint24s(dst []byte, src []uint32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint24sBE(dst, src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint24s converts 24-bit values in big-endian order to a slice of uint32 values, storing the result in the
+// provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint32 values.
+//	src: A byte slice containing at least 3*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint24s(dst []uint32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint24sBE(dst, src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint24sLE converts a slice of uint32 values to 24-bit values in little-endian order, storing the result in the
+// provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 3*len(src) bytes to store the resulting bytes.
+//	src: The uint32 values to convert, only their low 24 bits are stored.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint24sLE(dst []byte, src []uint32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint24sLE(dst, src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint24sLE converts 24-bit values in little-endian order to a slice of uint32 values, storing the result in the
+// provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint32 values.
+//	src: A byte slice containing at least 3*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint24sLE(dst []uint32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint24sLE(dst, src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt24s converts a slice of int32 values to 24-bit values in big-endian order, storing the result in the
+// provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 3*len(src) bytes to store the resulting bytes.
+//	src: The int32 values to convert, only their low 24 bits are stored.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt24s(dst []byte, src []int32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint24sBE(dst, sliceBits[uint32](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt24s converts 24-bit values in big-endian order to a slice of int32 values sign-extended from 24 bits, storing
+// the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int32 values.
+//	src: A byte slice containing at least 3*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt24s(dst []int32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint24sBE(sliceBits[uint32](dst), src)
+
+	// Sign-extend the values in place
+	for i, value := range dst {
+		dst[i] = signExtend32(uint32(value), 24)
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt24sLE converts a slice of int32 values to 24-bit values in little-endian order, storing the result in the
+// provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 3*len(src) bytes to store the resulting bytes.
+//	src: The int32 values to convert, only their low 24 bits are stored.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt24sLE(dst []byte, src []int32) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint24sLE(dst, sliceBits[uint32](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt24sLE converts 24-bit values in little-endian order to a slice of int32 values sign-extended from 24 bits,
+// storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int32 values.
+//	src: A byte slice containing at least 3*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt24sLE(dst []int32, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint24sLE(sliceBits[uint32](dst), src)
+
+	// Sign-extend the values in place
+	for i, value := range dst {
+		dst[i] = signExtend32(uint32(value), 24)
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint48s converts a slice of uint64 values to 48-bit values in big-endian order, storing the result in the
+// provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 6*len(src) bytes to store the resulting bytes.
+//	src: The uint64 values to convert, only their low 48 bits are stored.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint48s(dst []byte, src []uint64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint48sBE(dst, src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint48s converts 48-bit values in big-endian order to a slice of uint64 values, storing the result in the
+// provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint64 values.
+//	src: A byte slice containing at least 6*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint48s(dst []uint64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint48sBE(dst, src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutUint48sLE converts a slice of uint64 values to 48-bit values in little-endian order, storing the result in the
+// provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 6*len(src) bytes to store the resulting bytes.
+//	src: The uint64 values to convert, only their low 48 bits are stored.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutUint48sLE(dst []byte, src []uint64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint48sLE(dst, src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint48sLE converts 48-bit values in little-endian order to a slice of uint64 values, storing the result in the
+// provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting uint64 values.
+//	src: A byte slice containing at least 6*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetUint48sLE(dst []uint64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint48sLE(dst, src)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt48s converts a slice of int64 values to 48-bit values in big-endian order, storing the result in the
+// provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 6*len(src) bytes to store the resulting bytes.
+//	src: The int64 values to convert, only their low 48 bits are stored.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt48s(dst []byte, src []int64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint48sBE(dst, sliceBits[uint64](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt48s converts 48-bit values in big-endian order to a slice of int64 values sign-extended from 48 bits, storing
+// the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int64 values.
+//	src: A byte slice containing at least 6*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt48s(dst []int64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint48sBE(sliceBits[uint64](dst), src)
+
+	// Sign-extend the values in place
+	for i, value := range dst {
+		dst[i] = SignExtend(uint64(value), 48)
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutInt48sLE converts a slice of int64 values to 48-bit values in little-endian order, storing the result in the
+// provided buffer
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 6*len(src) bytes to store the resulting bytes.
+//	src: The int64 values to convert, only their low 48 bits are stored.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutInt48sLE(dst []byte, src []int64) tinygoerrors.ErrorCode {
+	if len(dst) < len(src)*6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	putUint48sLE(dst, sliceBits[uint64](src))
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt48sLE converts 48-bit values in little-endian order to a slice of int64 values sign-extended from 48 bits,
+// storing the result in the provided slice
+//
+// Parameters:
+//
+//	dst: The slice to store the resulting int64 values.
+//	src: A byte slice containing at least 6*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetInt48sLE(dst []int64, src []byte) tinygoerrors.ErrorCode {
+	if len(src) < len(dst)*6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	getUint48sLE(sliceBits[uint64](dst), src)
+
+	// Sign-extend the values in place
+	for i, value := range dst {
+		dst[i] = SignExtend(uint64(value), 48)
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutSlice converts a slice of numbers to bytes in the given byte order, storing the result in the provided buffer.
+// Big-endian and little-endian slices are converted by the unrolled loops of the fixed-order functions, and the
+// word-swapped orders reverse the 16-bit words of every element afterwards
+//
+// Parameters:
+//
+//	order: The byte order to use.
+//	dst: A byte slice with at least SizeOf[T]()*len(src) bytes to store the resulting bytes.
+//	src: The values to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutSlice[T Number](order ByteOrder, dst []byte, src []T) tinygoerrors.ErrorCode {
+	if order > LittleEndianWordSwap {
+		return ErrorCodeBuffersInvalidByteOrder
+	}
+	size := SizeOf[T]()
+	if len(dst) < len(src)*size {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+
+	little := order == LittleEndian || order == LittleEndianWordSwap
+	switch size {
+	case 1:
+		copy(dst, sliceBits[uint8](src))
+	case 2:
+		if little {
+			putUint16sLE(dst, sliceBits[uint16](src))
+		} else {
+			putUint16sBE(dst, sliceBits[uint16](src))
+		}
+	case 4:
+		if little {
+			putUint32sLE(dst, sliceBits[uint32](src))
+		} else {
+			putUint32sBE(dst, sliceBits[uint32](src))
+		}
+	default:
+		if little {
+			putUint64sLE(dst, sliceBits[uint64](src))
+		} else {
+			putUint64sBE(dst, sliceBits[uint64](src))
+		}
+	}
+	if order == BigEndianWordSwap || order == LittleEndianWordSwap {
+		swapWords(dst[:len(src)*size], size)
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetSlice converts bytes in the given byte order to a slice of numbers, storing the result in the provided slice
+//
+// Parameters:
+//
+//	order: The byte order to use.
+//	dst: The slice to store the resulting values.
+//	src: A byte slice containing at least SizeOf[T]()*len(dst) bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func GetSlice[T Number](order ByteOrder, dst []T, src []byte) tinygoerrors.ErrorCode {
+	if order > LittleEndianWordSwap {
+		return ErrorCodeBuffersInvalidByteOrder
+	}
+	size := SizeOf[T]()
+	if len(src) < len(dst)*size {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+
+	little := order == LittleEndian || order == LittleEndianWordSwap
+	switch size {
+	case 1:
+		copy(sliceBits[uint8](dst), src)
+	case 2:
+		if little {
+			getUint16sLE(sliceBits[uint16](dst), src)
+		} else {
+			getUint16sBE(sliceBits[uint16](dst), src)
+		}
+	case 4:
+		if little {
+			getUint32sLE(sliceBits[uint32](dst), src)
+		} else {
+			getUint32sBE(sliceBits[uint32](dst), src)
+		}
+	default:
+		if little {
+			getUint64sLE(sliceBits[uint64](dst), src)
+		} else {
+			getUint64sBE(sliceBits[uint64](dst), src)
+		}
+	}
+	if order == BigEndianWordSwap || order == LittleEndianWordSwap {
+		for i, value := range dst {
+			dst[i] = wordSwap(value)
+		}
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// SwapBytes16 reverses the byte order of every 16-bit value of a byte slice in place, converting a buffer of
+// 16-bit values between big-endian and little-endian order
+//
+// Parameters:
+//
+//	data: The bytes to swap, whose length must be a multiple of 2.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func SwapBytes16(data []byte) tinygoerrors.ErrorCode {
+	if len(data)%2 != 0 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	for ; len(data) >= 2*bulkUnroll; data = data[2*bulkUnroll:] {
+		binary.LittleEndian.PutUint16(data, binary.BigEndian.Uint16(data))
+		binary.LittleEndian.PutUint16(data[2:], binary.BigEndian.Uint16(data[2:]))
+		binary.LittleEndian.PutUint16(data[4:], binary.BigEndian.Uint16(data[4:]))
+		binary.LittleEndian.PutUint16(data[6:], binary.BigEndian.Uint16(data[6:]))
+	}
+	for ; len(data) >= 2; data = data[2:] {
+		binary.LittleEndian.PutUint16(data, binary.BigEndian.Uint16(data))
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// SwapBytes32 reverses the byte order of every 32-bit value of a byte slice in place, converting a buffer of
+// 32-bit values between big-endian and little-endian order
+//
+// Parameters:
+//
+//	data: The bytes to swap, whose length must be a multiple of 4.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func SwapBytes32(data []byte) tinygoerrors.ErrorCode {
+	if len(data)%4 != 0 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	for ; len(data) >= 4*bulkUnroll; data = data[4*bulkUnroll:] {
+		binary.LittleEndian.PutUint32(data, binary.BigEndian.Uint32(data))
+		binary.LittleEndian.PutUint32(data[4:], binary.BigEndian.Uint32(data[4:]))
+		binary.LittleEndian.PutUint32(data[8:], binary.BigEndian.Uint32(data[8:]))
+		binary.LittleEndian.PutUint32(data[12:], binary.BigEndian.Uint32(data[12:]))
+	}
+	for ; len(data) >= 4; data = data[4:] {
+		binary.LittleEndian.PutUint32(data, binary.BigEndian.Uint32(data))
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// SwapBytes64 reverses the byte order of every 64-bit value of a byte slice in place, converting a buffer of
+// 64-bit values between big-endian and little-endian order
+//
+// Parameters:
+//
+//	data: The bytes to swap, whose length must be a multiple of 8.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func SwapBytes64(data []byte) tinygoerrors.ErrorCode {
+	if len(data)%8 != 0 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	for ; len(data) >= 8*bulkUnroll; data = data[8*bulkUnroll:] {
+		binary.LittleEndian.PutUint64(data, binary.BigEndian.Uint64(data))
+		binary.LittleEndian.PutUint64(data[8:], binary.BigEndian.Uint64(data[8:]))
+		binary.LittleEndian.PutUint64(data[16:], binary.BigEndian.Uint64(data[16:]))
+		binary.LittleEndian.PutUint64(data[24:], binary.BigEndian.Uint64(data[24:]))
+	}
+	for ; len(data) >= 8; data = data[8:] {
+		binary.LittleEndian.PutUint64(data, binary.BigEndian.Uint64(data))
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// putUint16sBE writes 16-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 2*len(src) bytes.
+//	src: The values to write.
+func putUint16sBE(dst []byte, src []uint16) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		binary.BigEndian.PutUint16(dst, src[0])
+		binary.BigEndian.PutUint16(dst[2:], src[1])
+		binary.BigEndian.PutUint16(dst[4:], src[2])
+		binary.BigEndian.PutUint16(dst[6:], src[3])
+		dst = dst[2*bulkUnroll:]
+	}
+	for i, value := range src {
+		binary.BigEndian.PutUint16(dst[i*2:], value)
+	}
+}
+
+// getUint16sBE reads 16-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 2*len(dst) bytes.
+func getUint16sBE(dst []uint16, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = binary.BigEndian.Uint16(src)
+		dst[1] = binary.BigEndian.Uint16(src[2:])
+		dst[2] = binary.BigEndian.Uint16(src[4:])
+		dst[3] = binary.BigEndian.Uint16(src[6:])
+		src = src[2*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = binary.BigEndian.Uint16(src[i*2:])
+	}
+}
+
+// putUint16sLE writes 16-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 2*len(src) bytes.
+//	src: The values to write.
+func putUint16sLE(dst []byte, src []uint16) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		binary.LittleEndian.PutUint16(dst, src[0])
+		binary.LittleEndian.PutUint16(dst[2:], src[1])
+		binary.LittleEndian.PutUint16(dst[4:], src[2])
+		binary.LittleEndian.PutUint16(dst[6:], src[3])
+		dst = dst[2*bulkUnroll:]
+	}
+	for i, value := range src {
+		binary.LittleEndian.PutUint16(dst[i*2:], value)
+	}
+}
+
+// getUint16sLE reads 16-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 2*len(dst) bytes.
+func getUint16sLE(dst []uint16, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = binary.LittleEndian.Uint16(src)
+		dst[1] = binary.LittleEndian.Uint16(src[2:])
+		dst[2] = binary.LittleEndian.Uint16(src[4:])
+		dst[3] = binary.LittleEndian.Uint16(src[6:])
+		src = src[2*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = binary.LittleEndian.Uint16(src[i*2:])
+	}
+}
+
+// putUint32sBE writes 32-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 4*len(src) bytes.
+//	src: The values to write.
+func putUint32sBE(dst []byte, src []uint32) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		binary.BigEndian.PutUint32(dst, src[0])
+		binary.BigEndian.PutUint32(dst[4:], src[1])
+		binary.BigEndian.PutUint32(dst[8:], src[2])
+		binary.BigEndian.PutUint32(dst[12:], src[3])
+		dst = dst[4*bulkUnroll:]
+	}
+	for i, value := range src {
+		binary.BigEndian.PutUint32(dst[i*4:], value)
+	}
+}
+
+// getUint32sBE reads 32-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 4*len(dst) bytes.
+func getUint32sBE(dst []uint32, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = binary.BigEndian.Uint32(src)
+		dst[1] = binary.BigEndian.Uint32(src[4:])
+		dst[2] = binary.BigEndian.Uint32(src[8:])
+		dst[3] = binary.BigEndian.Uint32(src[12:])
+		src = src[4*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = binary.BigEndian.Uint32(src[i*4:])
+	}
+}
+
+// putUint32sLE writes 32-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 4*len(src) bytes.
+//	src: The values to write.
+func putUint32sLE(dst []byte, src []uint32) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		binary.LittleEndian.PutUint32(dst, src[0])
+		binary.LittleEndian.PutUint32(dst[4:], src[1])
+		binary.LittleEndian.PutUint32(dst[8:], src[2])
+		binary.LittleEndian.PutUint32(dst[12:], src[3])
+		dst = dst[4*bulkUnroll:]
+	}
+	for i, value := range src {
+		binary.LittleEndian.PutUint32(dst[i*4:], value)
+	}
+}
+
+// getUint32sLE reads 32-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 4*len(dst) bytes.
+func getUint32sLE(dst []uint32, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = binary.LittleEndian.Uint32(src)
+		dst[1] = binary.LittleEndian.Uint32(src[4:])
+		dst[2] = binary.LittleEndian.Uint32(src[8:])
+		dst[3] = binary.LittleEndian.Uint32(src[12:])
+		src = src[4*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = binary.LittleEndian.Uint32(src[i*4:])
+	}
+}
+
+// putUint64sBE writes 64-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 8*len(src) bytes.
+//	src: The values to write.
+func putUint64sBE(dst []byte, src []uint64) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		binary.BigEndian.PutUint64(dst, src[0])
+		binary.BigEndian.PutUint64(dst[8:], src[1])
+		binary.BigEndian.PutUint64(dst[16:], src[2])
+		binary.BigEndian.PutUint64(dst[24:], src[3])
+		dst = dst[8*bulkUnroll:]
+	}
+	for i, value := range src {
+		binary.BigEndian.PutUint64(dst[i*8:], value)
+	}
+}
+
+// getUint64sBE reads 64-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 8*len(dst) bytes.
+func getUint64sBE(dst []uint64, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = binary.BigEndian.Uint64(src)
+		dst[1] = binary.BigEndian.Uint64(src[8:])
+		dst[2] = binary.BigEndian.Uint64(src[16:])
+		dst[3] = binary.BigEndian.Uint64(src[24:])
+		src = src[8*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = binary.BigEndian.Uint64(src[i*8:])
+	}
+}
+
+// putUint64sLE writes 64-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 8*len(src) bytes.
+//	src: The values to write.
+func putUint64sLE(dst []byte, src []uint64) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		binary.LittleEndian.PutUint64(dst, src[0])
+		binary.LittleEndian.PutUint64(dst[8:], src[1])
+		binary.LittleEndian.PutUint64(dst[16:], src[2])
+		binary.LittleEndian.PutUint64(dst[24:], src[3])
+		dst = dst[8*bulkUnroll:]
+	}
+	for i, value := range src {
+		binary.LittleEndian.PutUint64(dst[i*8:], value)
+	}
+}
+
+// getUint64sLE reads 64-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 8*len(dst) bytes.
+func getUint64sLE(dst []uint64, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = binary.LittleEndian.Uint64(src)
+		dst[1] = binary.LittleEndian.Uint64(src[8:])
+		dst[2] = binary.LittleEndian.Uint64(src[16:])
+		dst[3] = binary.LittleEndian.Uint64(src[24:])
+		src = src[8*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = binary.LittleEndian.Uint64(src[i*8:])
+	}
+}
+
+// putUint24sBE writes 24-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 3*len(src) bytes.
+//	src: The values to write, only their low 24 bits are stored.
+func putUint24sBE(dst []byte, src []uint32) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		putUint24BE(dst, src[0])
+		putUint24BE(dst[3:], src[1])
+		putUint24BE(dst[6:], src[2])
+		putUint24BE(dst[9:], src[3])
+		dst = dst[3*bulkUnroll:]
+	}
+	for i, value := range src {
+		putUint24BE(dst[i*3:], value)
+	}
+}
+
+// getUint24sBE reads 24-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 3*len(dst) bytes.
+func getUint24sBE(dst []uint32, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = uint24BE(src)
+		dst[1] = uint24BE(src[3:])
+		dst[2] = uint24BE(src[6:])
+		dst[3] = uint24BE(src[9:])
+		src = src[3*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = uint24BE(src[i*3:])
+	}
+}
+
+// putUint24sLE writes 24-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 3*len(src) bytes.
+//	src: The values to write, only their low 24 bits are stored.
+func putUint24sLE(dst []byte, src []uint32) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		putUint24LE(dst, src[0])
+		putUint24LE(dst[3:], src[1])
+		putUint24LE(dst[6:], src[2])
+		putUint24LE(dst[9:], src[3])
+		dst = dst[3*bulkUnroll:]
+	}
+	for i, value := range src {
+		putUint24LE(dst[i*3:], value)
+	}
+}
+
+// getUint24sLE reads 24-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 3*len(dst) bytes.
+func getUint24sLE(dst []uint32, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = uint24LE(src)
+		dst[1] = uint24LE(src[3:])
+		dst[2] = uint24LE(src[6:])
+		dst[3] = uint24LE(src[9:])
+		src = src[3*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = uint24LE(src[i*3:])
+	}
+}
+
+// putUint48sBE writes 48-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 6*len(src) bytes.
+//	src: The values to write, only their low 48 bits are stored.
+func putUint48sBE(dst []byte, src []uint64) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		putUint48BE(dst, src[0])
+		putUint48BE(dst[6:], src[1])
+		putUint48BE(dst[12:], src[2])
+		putUint48BE(dst[18:], src[3])
+		dst = dst[6*bulkUnroll:]
+	}
+	for i, value := range src {
+		putUint48BE(dst[i*6:], value)
+	}
+}
+
+// getUint48sBE reads 48-bit values in big-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 6*len(dst) bytes.
+func getUint48sBE(dst []uint64, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = uint48BE(src)
+		dst[1] = uint48BE(src[6:])
+		dst[2] = uint48BE(src[12:])
+		dst[3] = uint48BE(src[18:])
+		src = src[6*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = uint48BE(src[i*6:])
+	}
+}
+
+// putUint48sLE writes 48-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: A byte slice with at least 6*len(src) bytes.
+//	src: The values to write, only their low 48 bits are stored.
+func putUint48sLE(dst []byte, src []uint64) {
+	for ; len(src) >= bulkUnroll; src = src[bulkUnroll:] {
+		putUint48LE(dst, src[0])
+		putUint48LE(dst[6:], src[1])
+		putUint48LE(dst[12:], src[2])
+		putUint48LE(dst[18:], src[3])
+		dst = dst[6*bulkUnroll:]
+	}
+	for i, value := range src {
+		putUint48LE(dst[i*6:], value)
+	}
+}
+
+// getUint48sLE reads 48-bit values in little-endian order, four per iteration
+//
+// Parameters:
+//
+//	dst: The slice to store the values.
+//	src: A byte slice with at least 6*len(dst) bytes.
+func getUint48sLE(dst []uint64, src []byte) {
+	for ; len(dst) >= bulkUnroll; dst = dst[bulkUnroll:] {
+		dst[0] = uint48LE(src)
+		dst[1] = uint48LE(src[6:])
+		dst[2] = uint48LE(src[12:])
+		dst[3] = uint48LE(src[18:])
+		src = src[6*bulkUnroll:]
+	}
+	for i := range dst {
+		dst[i] = uint48LE(src[i*6:])
+	}
+}
+
+// putUint24BE writes the low 24 bits of a value in big-endian order
+func putUint24BE(b []byte, value uint32) {
+	_ = b[2] // bounds check hint to the compiler
+	b[0] = byte(value >> 16)
+	b[1] = byte(value >> 8)
+	b[2] = byte(value)
+}
+
+// putUint24LE writes the low 24 bits of a value in little-endian order
+func putUint24LE(b []byte, value uint32) {
+	_ = b[2] // bounds check hint to the compiler
+	b[0] = byte(value)
+	b[1] = byte(value >> 8)
+	b[2] = byte(value >> 16)
+}
+
+// uint24BE reads a 24-bit value in big-endian order
+func uint24BE(b []byte) uint32 {
+	_ = b[2] // bounds check hint to the compiler
+	return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+}
+
+// uint24LE reads a 24-bit value in little-endian order
+func uint24LE(b []byte) uint32 {
+	_ = b[2] // bounds check hint to the compiler
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
+
+// putUint48BE writes the low 48 bits of a value in big-endian order
+func putUint48BE(b []byte, value uint64) {
+	binary.BigEndian.PutUint16(b, uint16(value>>32))
+	binary.BigEndian.PutUint32(b[2:], uint32(value))
+}
+
+// putUint48LE writes the low 48 bits of a value in little-endian order
+func putUint48LE(b []byte, value uint64) {
+	binary.LittleEndian.PutUint32(b, uint32(value))
+	binary.LittleEndian.PutUint16(b[4:], uint16(value>>32))
+}
+
+// uint48BE reads a 48-bit value in big-endian order
+func uint48BE(b []byte) uint64 {
+	return uint64(binary.BigEndian.Uint16(b))<<32 | uint64(binary.BigEndian.Uint32(b[2:]))
+}
+
+// uint48LE reads a 48-bit value in little-endian order
+func uint48LE(b []byte) uint64 {
+	return uint64(binary.LittleEndian.Uint32(b)) | uint64(binary.LittleEndian.Uint16(b[4:]))<<32
+}
+
+// swapWords reverses the order of the 16-bit words of every element of a byte slice in place
+//
+// Parameters:
+//
+//	data: The bytes to swap, whose length must be a multiple of size.
+//	size: The size of the elements in bytes.
+func swapWords(data []byte, size int) {
+	for ; len(data) >= size; data = data[size:] {
+		for i, j := 0, size-2; i < j; i, j = i+2, j-2 {
+			data[i], data[i+1], data[j], data[j+1] = data[j], data[j+1], data[i], data[i+1]
+		}
+	}
+}
+
+// sliceBits reinterprets a slice of numbers as a slice of unsigned integers of the same size, sharing its memory
+//
+// Parameters:
+//
+//	values: The slice to reinterpret, whose element size must be the size of B.
+//
+// Returns:
+//
+// The slice of the bits of the values.
+func sliceBits[B uint8 | uint16 | uint32 | uint64, T Number](values []T) []B {
+	return unsafe.Slice((*B)(unsafe.Pointer(unsafe.SliceData(values))), len(values))
+}
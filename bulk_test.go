@@ -0,0 +1,206 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// bulkMaxLength is the largest slice length checked, covering the unrolled iterations and every tail length
+const bulkMaxLength = 9
+
+// bulkValues returns n values of type T built from random bits
+func bulkValues[T Number](rng *rand.Rand, n int) []T {
+	values := make([]T, n)
+	for i := range values {
+		values[i] = numberFromBits[T](rng.Uint64())
+	}
+	return values
+}
+
+// checkBulk compares a bulk converter pair with the per-element functions for every length up to bulkMaxLength
+//
+// Parameters:
+//
+//	t: The test.
+//	name: The name of the bulk converters.
+//	size: The encoded size of an element.
+//	put: The bulk encoder.
+//	get: The bulk decoder.
+//	putOne: The per-element encoder.
+//	getOne: The per-element decoder.
+func checkBulk[T Number](
+	t *testing.T,
+	name string,
+	size int,
+	put func([]byte, []T) tinygoerrors.ErrorCode,
+	get func([]T, []byte) tinygoerrors.ErrorCode,
+	putOne func([]byte, T) tinygoerrors.ErrorCode,
+	getOne func([]byte) (T, tinygoerrors.ErrorCode),
+) {
+	t.Helper()
+	rng := rand.New(rand.NewSource(1))
+	for n := 0; n <= bulkMaxLength; n++ {
+		values := bulkValues[T](rng, n)
+
+		// Encode with a trailing guard byte, which must not be written
+		got := make([]byte, n*size+1)
+		want := make([]byte, n*size+1)
+		got[n*size], want[n*size] = 0xA5, 0xA5
+		if err := put(got, values); err != tinygoerrors.ErrorCodeNil {
+			t.Fatalf("Put%s with %d values returned error %d", name, n, err)
+		}
+		for i, value := range values {
+			putOne(want[i*size:], value)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("Put%s(%v) = % X, want % X", name, values, got, want)
+		}
+
+		// Decode random bytes, so the sign extension of the narrow formats is checked too. They may hold NaNs, so the
+		// bits are compared
+		encoded := make([]byte, n*size)
+		rng.Read(encoded)
+		decoded := make([]T, n)
+		if err := get(decoded, encoded); err != tinygoerrors.ErrorCodeNil {
+			t.Fatalf("Get%s with %d values returned error %d", name, n, err)
+		}
+		for i := range decoded {
+			if value, _ := getOne(encoded[i*size:]); numberBits(decoded[i]) != numberBits(value) {
+				t.Fatalf("Get%s(% X)[%d] = %v, want %v", name, encoded, i, decoded[i], value)
+			}
+		}
+
+		// Short buffers are rejected
+		if n > 0 {
+			if err := put(got[:n*size-1], values); err != ErrorCodeBuffersInvalidBufferSize {
+				t.Errorf("Put%s with a short buffer returned error %d", name, err)
+			}
+			if err := get(decoded, encoded[:n*size-1]); err != ErrorCodeBuffersInvalidBufferSize {
+				t.Errorf("Get%s with a short buffer returned error %d", name, err)
+			}
+		}
+	}
+}
+
+// TestBulkFixedOrder checks the big-endian and little-endian bulk converters against PutBE, PutLE, GetBE and GetLE
+func TestBulkFixedOrder(t *testing.T) {
+	checkBulk(t, "Uint16s", 2, PutUint16s, GetUint16s, PutBE[uint16], GetBE[uint16])
+	checkBulk(t, "Uint16sLE", 2, PutUint16sLE, GetUint16sLE, PutLE[uint16], GetLE[uint16])
+	checkBulk(t, "Int16s", 2, PutInt16s, GetInt16s, PutBE[int16], GetBE[int16])
+	checkBulk(t, "Int16sLE", 2, PutInt16sLE, GetInt16sLE, PutLE[int16], GetLE[int16])
+	checkBulk(t, "Uint32s", 4, PutUint32s, GetUint32s, PutBE[uint32], GetBE[uint32])
+	checkBulk(t, "Uint32sLE", 4, PutUint32sLE, GetUint32sLE, PutLE[uint32], GetLE[uint32])
+	checkBulk(t, "Int32s", 4, PutInt32s, GetInt32s, PutBE[int32], GetBE[int32])
+	checkBulk(t, "Int32sLE", 4, PutInt32sLE, GetInt32sLE, PutLE[int32], GetLE[int32])
+	checkBulk(t, "Float32s", 4, PutFloat32s, GetFloat32s, PutBE[float32], GetBE[float32])
+	checkBulk(t, "Float32sLE", 4, PutFloat32sLE, GetFloat32sLE, PutLE[float32], GetLE[float32])
+	checkBulk(t, "Uint64s", 8, PutUint64s, GetUint64s, PutBE[uint64], GetBE[uint64])
+	checkBulk(t, "Uint64sLE", 8, PutUint64sLE, GetUint64sLE, PutLE[uint64], GetLE[uint64])
+	checkBulk(t, "Int64s", 8, PutInt64s, GetInt64s, PutBE[int64], GetBE[int64])
+	checkBulk(t, "Int64sLE", 8, PutInt64sLE, GetInt64sLE, PutLE[int64], GetLE[int64])
+	checkBulk(t, "Float64s", 8, PutFloat64s, GetFloat64s, PutBE[float64], GetBE[float64])
+	checkBulk(t, "Float64sLE", 8, PutFloat64sLE, GetFloat64sLE, PutLE[float64], GetLE[float64])
+}
+
+// TestBulk24And48 checks the 24-bit and 48-bit bulk converters against the per-element functions
+func TestBulk24And48(t *testing.T) {
+	checkBulk(t, "Uint24s", 3, PutUint24s, GetUint24s, func(b []byte, v uint32) tinygoerrors.ErrorCode {
+		return Uint24ToBytes(v, b)
+	}, BytesToUint24)
+	checkBulk(t, "Uint24sLE", 3, PutUint24sLE, GetUint24sLE, func(b []byte, v uint32) tinygoerrors.ErrorCode {
+		return Uint24ToBytesLE(v, b)
+	}, BytesToUint24LE)
+	checkBulk(t, "Int24s", 3, PutInt24s, GetInt24s, func(b []byte, v int32) tinygoerrors.ErrorCode {
+		return Int24ToBytes(v, b)
+	}, BytesToInt24)
+	checkBulk(t, "Int24sLE", 3, PutInt24sLE, GetInt24sLE, func(b []byte, v int32) tinygoerrors.ErrorCode {
+		return Int24ToBytesLE(v, b)
+	}, BytesToInt24LE)
+	checkBulk(t, "Uint48s", 6, PutUint48s, GetUint48s, func(b []byte, v uint64) tinygoerrors.ErrorCode {
+		return Uint48ToBytes(v, b)
+	}, BytesToUint48)
+	checkBulk(t, "Uint48sLE", 6, PutUint48sLE, GetUint48sLE, func(b []byte, v uint64) tinygoerrors.ErrorCode {
+		return Uint48ToBytesLE(v, b)
+	}, BytesToUint48LE)
+	checkBulk(t, "Int48s", 6, PutInt48s, GetInt48s, func(b []byte, v int64) tinygoerrors.ErrorCode {
+		return Int48ToBytes(v, b)
+	}, BytesToInt48)
+	checkBulk(t, "Int48sLE", 6, PutInt48sLE, GetInt48sLE, func(b []byte, v int64) tinygoerrors.ErrorCode {
+		return Int48ToBytesLE(v, b)
+	}, BytesToInt48LE)
+}
+
+// checkSlice checks PutSlice and GetSlice against Put and Get in every byte order
+func checkSlice[T Number](t *testing.T, name string) {
+	t.Helper()
+	for _, order := range []ByteOrder{BigEndian, LittleEndian, BigEndianWordSwap, LittleEndianWordSwap} {
+		checkBulk(
+			t,
+			name,
+			SizeOf[T](),
+			func(dst []byte, src []T) tinygoerrors.ErrorCode { return PutSlice(order, dst, src) },
+			func(dst []T, src []byte) tinygoerrors.ErrorCode { return GetSlice(order, dst, src) },
+			func(buffer []byte, value T) tinygoerrors.ErrorCode { return Put(order, buffer, value) },
+			func(data []byte) (T, tinygoerrors.ErrorCode) { return Get[T](order, data) },
+		)
+	}
+
+	// Unknown byte orders are rejected
+	values := make([]T, 1)
+	buffer := make([]byte, SizeOf[T]())
+	if err := PutSlice(LittleEndianWordSwap+1, buffer, values); err != ErrorCodeBuffersInvalidByteOrder {
+		t.Errorf("PutSlice with an invalid byte order returned error %d", err)
+	}
+	if err := GetSlice(LittleEndianWordSwap+1, values, buffer); err != ErrorCodeBuffersInvalidByteOrder {
+		t.Errorf("GetSlice with an invalid byte order returned error %d", err)
+	}
+}
+
+// TestBulkSlice checks the order-parameterised bulk converters for every Number type
+func TestBulkSlice(t *testing.T) {
+	checkSlice[uint8](t, "Slice[uint8]")
+	checkSlice[int8](t, "Slice[int8]")
+	checkSlice[uint16](t, "Slice[uint16]")
+	checkSlice[int16](t, "Slice[int16]")
+	checkSlice[uint32](t, "Slice[uint32]")
+	checkSlice[int32](t, "Slice[int32]")
+	checkSlice[float32](t, "Slice[float32]")
+	checkSlice[uint64](t, "Slice[uint64]")
+	checkSlice[int64](t, "Slice[int64]")
+	checkSlice[float64](t, "Slice[float64]")
+}
+
+// checkSwap checks an in-place byte swap against decoding every element in big-endian order and encoding it in
+// little-endian order
+func checkSwap[T Number](t *testing.T, name string, swap func([]byte) tinygoerrors.ErrorCode) {
+	t.Helper()
+	rng := rand.New(rand.NewSource(1))
+	size := SizeOf[T]()
+	for n := 0; n <= bulkMaxLength; n++ {
+		data := make([]byte, n*size)
+		rng.Read(data)
+		want := make([]byte, len(data))
+		for i := 0; i < n; i++ {
+			value, _ := GetBE[T](data[i*size:])
+			PutLE(want[i*size:], value)
+		}
+		if err := swap(data); err != tinygoerrors.ErrorCodeNil || !bytes.Equal(data, want) {
+			t.Fatalf("%s with %d values = % X, %d, want % X", name, n, data, err, want)
+		}
+		if n > 0 {
+			if err := swap(data[:n*size-1]); err != ErrorCodeBuffersInvalidBufferSize {
+				t.Errorf("%s with a partial value returned error %d", name, err)
+			}
+		}
+	}
+}
+
+// TestSwapBytes checks the in-place byte swaps for every length up to bulkMaxLength
+func TestSwapBytes(t *testing.T) {
+	checkSwap[uint16](t, "SwapBytes16", SwapBytes16)
+	checkSwap[uint32](t, "SwapBytes32", SwapBytes32)
+	checkSwap[uint64](t, "SwapBytes64", SwapBytes64)
+}
@@ -0,0 +1,106 @@
+package tinygo_buffers
+
+import (
+	"unsafe"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+type (
+	// ByteOrder is the order in which the bytes of a number are stored, selectable at runtime. The byte patterns below
+	// are those of the 32-bit value 0xAABBCCDD
+	ByteOrder uint8
+)
+
+const (
+	// BigEndian stores the most significant byte first: AA BB CC DD
+	BigEndian ByteOrder = iota
+
+	// LittleEndian stores the least significant byte first: DD CC BB AA
+	LittleEndian
+
+	// BigEndianWordSwap stores big-endian 16-bit words with the least significant word first: CC DD AA BB. It is the
+	// "word swapped" order used by many Modbus devices for 32-bit and 64-bit registers
+	BigEndianWordSwap
+
+	// LittleEndianWordSwap stores little-endian 16-bit words with the most significant word first: BB AA DD CC
+	LittleEndianWordSwap
+)
+
+// Put encodes a number in the given byte order, storing the result in the provided buffer. Floats are encoded with
+// their IEEE-754 bits
+//
+// Parameters:
+//
+//	order: The byte order to use.
+//	buffer: A byte slice with at least SizeOf[T]() bytes to store the resulting bytes.
+//	value: The value to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Put[T Number](order ByteOrder, buffer []byte, value T) tinygoerrors.ErrorCode {
+	switch order {
+	case BigEndian:
+		return PutBE(buffer, value)
+	case LittleEndian:
+		return PutLE(buffer, value)
+	case BigEndianWordSwap:
+		return PutBE(buffer, wordSwap(value))
+	case LittleEndianWordSwap:
+		return PutLE(buffer, wordSwap(value))
+	}
+	return ErrorCodeBuffersInvalidByteOrder
+}
+
+// Get decodes a number in the given byte order from a byte slice
+//
+// Parameters:
+//
+//	order: The byte order to use.
+//	data: A byte slice containing at least SizeOf[T]() bytes.
+//
+// Returns:
+//
+// The value represented by the first SizeOf[T]() bytes of the input slice, or an error code if the input or the byte
+// order is invalid.
+func Get[T Number](order ByteOrder, data []byte) (T, tinygoerrors.ErrorCode) {
+	var value T
+	var err tinygoerrors.ErrorCode
+	switch order {
+	case BigEndian:
+		return GetBE[T](data)
+	case LittleEndian:
+		return GetLE[T](data)
+	case BigEndianWordSwap:
+		value, err = GetBE[T](data)
+	case LittleEndianWordSwap:
+		value, err = GetLE[T](data)
+	default:
+		return value, ErrorCodeBuffersInvalidByteOrder
+	}
+	return wordSwap(value), err
+}
+
+// wordSwap reverses the order of the 16-bit words of a number, leaving 8-bit and 16-bit numbers unchanged
+//
+// Parameters:
+//
+//	value: The value to convert.
+//
+// Returns:
+//
+// The value with its words reversed.
+func wordSwap[T Number](value T) T {
+	bits := numberBits(value)
+	switch unsafe.Sizeof(value) {
+	case 4:
+		bits = bits>>16 | bits<<16
+	case 8:
+		bits = bits>>32 | bits<<32
+		bits = bits>>16&0x0000FFFF0000FFFF | bits<<16&0xFFFF0000FFFF0000
+	default:
+		return value
+	}
+	return numberFromBits[T](bits)
+}
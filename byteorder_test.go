@@ -0,0 +1,101 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// byteOrders are the supported byte orders, in the order of the expected encodings of checkOrders
+var byteOrders = [...]ByteOrder{BigEndian, LittleEndian, BigEndianWordSwap, LittleEndianWordSwap}
+
+// checkOrders checks Put and Get against the known encoding of a value in every byte order
+//
+// Parameters:
+//
+//	t: The test.
+//	value: The value to encode.
+//	want: The encodings in BigEndian, LittleEndian, BigEndianWordSwap and LittleEndianWordSwap order.
+func checkOrders[T Number](t *testing.T, value T, want [len(byteOrders)][]byte) {
+	t.Helper()
+	for i, order := range byteOrders {
+		buffer := make([]byte, SizeOf[T]())
+		if err := Put(order, buffer, value); err != tinygoerrors.ErrorCodeNil || !bytes.Equal(buffer, want[i]) {
+			t.Errorf("Put(%d, %T(%v)) = % X, %d, want % X", order, value, value, buffer, err, want[i])
+		}
+		got, err := Get[T](order, want[i])
+		if err != tinygoerrors.ErrorCodeNil || numberBits(got) != numberBits(value) {
+			t.Errorf("Get[%T](%d, % X) = %v, %d, want %v", value, order, want[i], got, err, value)
+		}
+
+		// Short buffers are rejected
+		if err := Put(order, buffer[:len(buffer)-1], value); err != ErrorCodeBuffersInvalidBufferSize {
+			t.Errorf("Put(%d, %T) with a short buffer returned error %d", order, value, err)
+		}
+		if _, err := Get[T](order, want[i][:len(want[i])-1]); err != ErrorCodeBuffersInvalidBufferSize {
+			t.Errorf("Get[%T](%d) with a short buffer returned error %d", value, order, err)
+		}
+	}
+
+	// Unknown byte orders are rejected
+	buffer := make([]byte, SizeOf[T]())
+	if err := Put(LittleEndianWordSwap+1, buffer, value); err != ErrorCodeBuffersInvalidByteOrder {
+		t.Errorf("Put(%d, %T) returned error %d", LittleEndianWordSwap+1, value, err)
+	}
+	if _, err := Get[T](LittleEndianWordSwap+1, buffer); err != ErrorCodeBuffersInvalidByteOrder {
+		t.Errorf("Get[%T](%d) returned error %d", value, LittleEndianWordSwap+1, err)
+	}
+}
+
+// TestByteOrder checks every byte order with known encodings of every size
+func TestByteOrder(t *testing.T) {
+	checkOrders(t, uint8(0xAB), [...][]byte{{0xAB}, {0xAB}, {0xAB}, {0xAB}})
+	checkOrders(t, int8(-2), [...][]byte{{0xFE}, {0xFE}, {0xFE}, {0xFE}})
+	checkOrders(t, uint16(0x1122), [...][]byte{{0x11, 0x22}, {0x22, 0x11}, {0x11, 0x22}, {0x22, 0x11}})
+	checkOrders(t, int16(-2), [...][]byte{{0xFF, 0xFE}, {0xFE, 0xFF}, {0xFF, 0xFE}, {0xFE, 0xFF}})
+
+	// The patterns of the ByteOrder documentation
+	checkOrders(t, uint32(0xAABBCCDD), [...][]byte{
+		{0xAA, 0xBB, 0xCC, 0xDD},
+		{0xDD, 0xCC, 0xBB, 0xAA},
+		{0xCC, 0xDD, 0xAA, 0xBB},
+		{0xBB, 0xAA, 0xDD, 0xCC},
+	})
+	checkOrders(t, uint32(0x11223344), [...][]byte{
+		{0x11, 0x22, 0x33, 0x44},
+		{0x44, 0x33, 0x22, 0x11},
+		{0x33, 0x44, 0x11, 0x22},
+		{0x22, 0x11, 0x44, 0x33},
+	})
+	checkOrders(t, int32(-2), [...][]byte{
+		{0xFF, 0xFF, 0xFF, 0xFE},
+		{0xFE, 0xFF, 0xFF, 0xFF},
+		{0xFF, 0xFE, 0xFF, 0xFF},
+		{0xFF, 0xFF, 0xFE, 0xFF},
+	})
+	checkOrders(t, float32(1.5), [...][]byte{
+		{0x3F, 0xC0, 0x00, 0x00},
+		{0x00, 0x00, 0xC0, 0x3F},
+		{0x00, 0x00, 0x3F, 0xC0},
+		{0xC0, 0x3F, 0x00, 0x00},
+	})
+	checkOrders(t, uint64(0x1122334455667788), [...][]byte{
+		{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88},
+		{0x88, 0x77, 0x66, 0x55, 0x44, 0x33, 0x22, 0x11},
+		{0x77, 0x88, 0x55, 0x66, 0x33, 0x44, 0x11, 0x22},
+		{0x22, 0x11, 0x44, 0x33, 0x66, 0x55, 0x88, 0x77},
+	})
+	checkOrders(t, int64(-2), [...][]byte{
+		{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE},
+		{0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		{0xFF, 0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE, 0xFF},
+	})
+	checkOrders(t, 1.5, [...][]byte{
+		{0x3F, 0xF8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xF8, 0x3F},
+		{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3F, 0xF8},
+		{0xF8, 0x3F, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	})
+}
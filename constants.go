@@ -1,5 +1,65 @@
 package tinygo_buffers
 
+const (
+	// UintToHexBufferSize is the size of the buffer used for converting uint64 to hex
+	UintToHexBufferSize = 16
+
+	// UintToHexPrefixedBufferSize is the size of the buffer used for converting uint64 to hex with HexPrefix
+	UintToHexPrefixedBufferSize = UintToHexBufferSize + 2
+
+	// UintToRadixBufferSize is the size of the buffer used for converting uint64 to any radix, large enough for the
+	// prefix and 64 binary digits separated in groups of four
+	UintToRadixBufferSize = 2 + 64 + 15
+
+	// UintToDecimalBufferSize is the size of the buffer used for converting uint64 to decimal
+	UintToDecimalBufferSize = 20
+
+	// IntToDecimalBufferSize is the size of the buffer used for converting int64 to decimal
+	IntToDecimalBufferSize = 20
+
+	// Float64ToDecimalBufferSize is the size of the buffer used for converting float64 to decimal
+	Float64ToDecimalBufferSize = 20
+
+	// float64Uint64Limit is the smallest float64 whose integer part does not fit in an uint64
+	float64Uint64Limit = 1 << 64
+
+	// float64FractionBits is the number of fractional bits of the fixed point used for the float64 fractional digits,
+	// leaving room to multiply by 10 in an uint64
+	float64FractionBits = 60
+
+	// float64ExponentMask is the mask of the exponent bits of a float64, which are all set for NaN and infinities
+	float64ExponentMask = 0x7FF << 52
+
+	// decimalDigitPairs holds the two ASCII digits of every number from 00 to 99, so decimal conversions emit two
+	// digits per division
+	decimalDigitPairs = "00010203040506070809" +
+		"10111213141516171819" +
+		"20212223242526272829" +
+		"30313233343536373839" +
+		"40414243444546474849" +
+		"50515253545556575859" +
+		"60616263646566676869" +
+		"70717273747576777879" +
+		"80818283848586878889" +
+		"90919293949596979899"
+
+	// decimalChunk is the power of ten split off an uint64 at a time until it fits in an uint32
+	decimalChunk = 100000000
+
+	// decimalChunkDigits is the number of decimal digits of a chunk below decimalChunk
+	decimalChunkDigits = 8
+
+	// decimalChunkReciprocal is ceil(2^82 / (decimalChunk >> 8)), so the high 64 bits of
+	// (v >> 8) * decimalChunkReciprocal, shifted right by decimalChunkShift, equal v / decimalChunk for every uint64
+	decimalChunkReciprocal = 0xABCC77118461CEFD
+
+	// decimalChunkShift is the extra right shift applied after the reciprocal multiplication by decimalChunkReciprocal
+	decimalChunkShift = 18
+
+	// decimalPairReciprocal is ceil(2^37 / 100), so (v * decimalPairReciprocal) >> 37 equals v / 100 for every uint32
+	decimalPairReciprocal = 0x51EB851F
+)
+
 var (
 	// WhitespaceBuffer is a byte slice representing a whitespace character
 	WhitespaceBuffer = []byte(" ")
@@ -16,27 +76,66 @@ var (
 	// DotBuffer is a byte slice representing a dot character
 	DotBuffer = []byte(".")
 
+	// NaNBuffer is a byte slice representing a float NaN value
+	NaNBuffer = []byte("NaN")
+
+	// PositiveInfBuffer is a byte slice representing a float positive infinity value
+	PositiveInfBuffer = []byte("+Inf")
+
+	// NegativeInfBuffer is a byte slice representing a float negative infinity value
+	NegativeInfBuffer = []byte("-Inf")
+
 	// HexPrefix is the prefix for error codes
 	HexPrefix = []byte("0x")
 
+	// BinaryPrefix is the prefix for binary numbers
+	BinaryPrefix = []byte("0b")
+
+	// OctalPrefix is the prefix for octal numbers
+	OctalPrefix = []byte("0o")
+
 	// Float64Buffer is the buffer used for float64 messages
 	Float64Buffer = [8]byte{}
 
 	// ASCIIHexDigits is a byte slice representing ASCII hex digits
 	ASCIIHexDigits = []byte("0123456789ABCDEF")
 
+	// ASCIILowerHexDigits is a byte slice representing ASCII lowercase hex digits
+	ASCIILowerHexDigits = []byte("0123456789abcdef")
+
+	// ASCIIRadixDigits is a byte slice representing the ASCII digits of the bases up to 36
+	ASCIIRadixDigits = []byte("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+
+	// ASCIILowerRadixDigits is a byte slice representing the ASCII lowercase digits of the bases up to 36
+	ASCIILowerRadixDigits = []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
 	// ASCIIDecimalDigits is a byte slice representing ASCII decimal digits
 	ASCIIDecimalDigits = []byte("0123456789")
 
 	// UintToHexBuffer is a buffer used for converting uint64 to hex
-	UintToHexBuffer = [16]byte{}
+	//
+	// Deprecated: Uint8ToHex, Uint16ToHex, Uint32ToHex and Uint64ToHex still copy their digits to the start of this
+	// buffer until it is removed, but return the scratch storage of the default Formatter. Use a Formatter or the
+	// Append* functions instead.
+	UintToHexBuffer = [UintToHexBufferSize]byte{}
 
 	// UintToDecimalBuffer is a buffer used for converting uint64 to decimal
-	UintToDecimalBuffer = [20]byte{}
+	//
+	// Deprecated: UintToDecimal still copies its digits to the end of this buffer, and UintToDecimalFixed to its
+	// start, until it is removed, but they return the scratch storage of the default Formatter. Use a Formatter or the
+	// Append* functions instead.
+	UintToDecimalBuffer = [UintToDecimalBufferSize]byte{}
 
 	// IntToDecimalBuffer is a buffer used for converting int64 to decimal
-	IntToDecimalBuffer = [20]byte{}
+	//
+	// Deprecated: IntToDecimal still copies its digits to the end of this buffer until it is removed, but returns the
+	// scratch storage of the default Formatter. Use a Formatter or the Append* functions instead.
+	IntToDecimalBuffer = [IntToDecimalBufferSize]byte{}
 
 	// Float64ToDecimalBuffer is a buffer used for converting float64 to decimal
-	Float64ToDecimalBuffer = [20]byte{}
+	//
+	// Deprecated: Float64ToDecimal and Float64ToDecimalRounded still copy their digits to the start of this buffer
+	// until it is removed, but return the scratch storage of the default Formatter. Use a Formatter or the Append*
+	// functions instead.
+	Float64ToDecimalBuffer = [Float64ToDecimalBufferSize]byte{}
 )
@@ -0,0 +1,506 @@
+package tinygo_buffers
+
+const (
+	// decimalParseDigits is the number of digits kept by a decimal used for parsing. It is enough to represent
+	// exactly the digits that decide the rounding of any float64, digits beyond it are only tracked as truncated
+	decimalParseDigits = 800
+
+	// decimalFormatDigits is the number of digits kept by a decimal used for formatting. It holds the 309 integer
+	// digits of the largest float64, while the digits of tiny values beyond the ones printed are only tracked as
+	// truncated
+	decimalFormatDigits = 310
+
+	// decimalShiftSlack is the extra storage a decimal needs after its digits, so a left shift has room for the new
+	// digits before they are truncated to the decimal capacity
+	decimalShiftSlack = 20
+
+	// decimalMaxShift is the maximum number of bits a decimal is shifted in a single pass, so the shifted digits
+	// never overflow an uint
+	decimalMaxShift = 32<<(^uint(0)>>63) - 4
+)
+
+type (
+	// decimal is a multiprecision decimal number used to convert between text and binary floating point without
+	// losing precision. Its digits are stored in an array on the stack of its caller, so no heap memory is used
+	decimal struct {
+		d     []byte // ASCII digits, big-endian, with decimalShiftSlack extra bytes
+		nd    int    // number of digits used
+		dp    int    // decimal point position relative to the first digit
+		neg   bool   // negative flag
+		trunc bool   // nonzero digits were discarded beyond d[:nd]
+	}
+
+	// floatInfo describes the layout of a binary floating point format
+	floatInfo struct {
+		mantBits uint
+		expBits  uint
+		bias     int
+	}
+)
+
+var (
+	// float32Info is the layout of a float32
+	float32Info = floatInfo{23, 8, -127}
+
+	// float64Info is the layout of a float64
+	float64Info = floatInfo{52, 11, -1023}
+)
+
+// float64Pow10 contains the powers of ten that are exactly representable in a float64
+var float64Pow10 = [...]float64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+	1e20, 1e21, 1e22,
+}
+
+// decimalPowTab contains the number of bits to shift a decimal for a given decimal point position, so the shift
+// never overshoots the range [0.5, 1)
+var decimalPowTab = [...]int{1, 3, 6, 9, 13, 16, 19, 23, 26}
+
+// set parses a syntactically valid decimal number into the decimal
+//
+// Parameters:
+//
+//	data: A byte slice containing digits, an optional dot and an optional exponent, without sign.
+//	neg: Whether the number is negative.
+func (a *decimal) set(data []byte, neg bool) {
+	a.nd, a.dp, a.neg, a.trunc = 0, 0, neg, false
+
+	// Read the digits, ignoring leading zeros. The discarded digits still count for the decimal point position
+	i, nd := 0, 0
+	sawDot := false
+	for ; i < len(data); i++ {
+		c := data[i]
+		if c == '.' {
+			sawDot = true
+			a.dp = nd
+			continue
+		}
+		if c < '0' || c > '9' {
+			break
+		}
+		if c == '0' && nd == 0 {
+			a.dp--
+			continue
+		}
+		nd++
+		if a.nd < a.capacity() {
+			a.d[a.nd] = c
+			a.nd++
+		} else if c != '0' {
+			a.trunc = true
+		}
+	}
+	if !sawDot {
+		a.dp = nd
+	}
+
+	// Read the optional exponent
+	if i < len(data) {
+		i++
+		sign := 1
+		if data[i] == '+' || data[i] == '-' {
+			if data[i] == '-' {
+				sign = -1
+			}
+			i++
+		}
+		exp := 0
+		for ; i < len(data); i++ {
+			if exp < 10000 {
+				exp = exp*10 + int(data[i]-'0')
+			}
+		}
+		a.dp += exp * sign
+	}
+	a.trim()
+}
+
+// assign sets the decimal to an uint64 value
+//
+// Parameters:
+//
+//	value: The value to assign.
+func (a *decimal) assign(value uint64) {
+	var buffer [UintToDecimalBufferSize]byte
+	digits := AppendUintDecimal(buffer[:0], value)
+	a.nd = copy(a.d, digits)
+	a.dp = a.nd
+	a.neg, a.trunc = false, false
+	a.trim()
+}
+
+// floatParts splits the bits of a binary floating point number into its integer mantissa and unbiased exponent
+//
+// Parameters:
+//
+//	bits: The bits of the floating point number, which must be finite.
+//	flt: The layout of the floating point format.
+//
+// Returns:
+//
+// The mantissa and the exponent, so the absolute value is mantissa * 2^(exponent - flt.mantBits).
+func floatParts(bits uint64, flt *floatInfo) (uint64, int) {
+	exp := int(bits>>flt.mantBits) & (1<<flt.expBits - 1)
+	mant := bits & (1<<flt.mantBits - 1)
+
+	// Denormalized values have no implicit leading bit
+	if exp == 0 {
+		exp = 1
+	} else {
+		mant |= 1 << flt.mantBits
+	}
+	return mant, exp + flt.bias
+}
+
+// assignFloat sets the decimal to the exact value of a binary floating point number
+//
+// Parameters:
+//
+//	bits: The bits of the floating point number, which must be finite.
+//	flt: The layout of the floating point format.
+//
+// Returns:
+//
+// The mantissa and the unbiased exponent of the number, as returned by floatParts.
+func (a *decimal) assignFloat(bits uint64, flt *floatInfo) (uint64, int) {
+	mant, exp := floatParts(bits, flt)
+	a.assign(mant)
+	a.shift(exp - int(flt.mantBits))
+	a.neg = bits>>(flt.expBits+flt.mantBits) != 0
+	return mant, exp
+}
+
+// capacity returns the number of digits the decimal can keep
+func (a *decimal) capacity() int {
+	return len(a.d) - decimalShiftSlack
+}
+
+// trim removes the trailing zeros of the decimal
+func (a *decimal) trim() {
+	for a.nd > 0 && a.d[a.nd-1] == '0' {
+		a.nd--
+	}
+	if a.nd == 0 {
+		a.dp = 0
+	}
+}
+
+// shift multiplies the decimal by 2^k
+//
+// Parameters:
+//
+//	k: The number of bits to shift, to the left if positive and to the right if negative.
+func (a *decimal) shift(k int) {
+	switch {
+	case a.nd == 0:
+	case k > 0:
+		for k > decimalMaxShift {
+			a.leftShift(decimalMaxShift)
+			k -= decimalMaxShift
+		}
+		a.leftShift(uint(k))
+	case k < 0:
+		for k < -decimalMaxShift {
+			a.rightShift(decimalMaxShift)
+			k += decimalMaxShift
+		}
+		a.rightShift(uint(-k))
+	}
+}
+
+// leftShift multiplies the decimal by 2^k, with k not greater than decimalMaxShift
+//
+// Parameters:
+//
+//	k: The number of bits to shift.
+func (a *decimal) leftShift(k uint) {
+	// Each bit adds less than 0.302 digits, so the result is written with room for k*3/10+1 new digits, which
+	// always fit in the slack, and then moved back to the start of the buffer
+	delta := int(k*3/10 + 1)
+	w := a.nd + delta
+	var n uint
+	for r := a.nd - 1; r >= 0; r-- {
+		n += uint(a.d[r]-'0') << k
+		quo := n / 10
+		rem := n - 10*quo
+		w--
+		a.d[w] = byte(rem + '0')
+		n = quo
+	}
+	for n > 0 {
+		quo := n / 10
+		rem := n - 10*quo
+		w--
+		a.d[w] = byte(rem + '0')
+		n = quo
+	}
+
+	// Move the digits to the start of the buffer, truncating them to the capacity
+	copy(a.d, a.d[w:a.nd+delta])
+	a.nd += delta - w
+	a.dp += delta - w
+	if a.nd > a.capacity() {
+		for _, c := range a.d[a.capacity():a.nd] {
+			if c != '0' {
+				a.trunc = true
+			}
+		}
+		a.nd = a.capacity()
+	}
+	a.trim()
+}
+
+// rightShift divides the decimal by 2^k, with k not greater than decimalMaxShift
+//
+// Parameters:
+//
+//	k: The number of bits to shift.
+func (a *decimal) rightShift(k uint) {
+	r := 0
+	w := 0
+
+	// Pick up enough leading digits to cover the first shift
+	var n uint
+	for ; n>>k == 0; r++ {
+		if r >= a.nd {
+			if n == 0 {
+				a.nd = 0
+				return
+			}
+			for n>>k == 0 {
+				n *= 10
+				r++
+			}
+			break
+		}
+		n = n*10 + uint(a.d[r]-'0')
+	}
+	a.dp -= r - 1
+
+	// Pick up a digit, put down a digit
+	mask := uint(1)<<k - 1
+	for ; r < a.nd; r++ {
+		c := uint(a.d[r] - '0')
+		digit := n >> k
+		n &= mask
+		a.d[w] = byte(digit + '0')
+		w++
+		n = n*10 + c
+	}
+
+	// Put down the extra digits
+	for n > 0 {
+		digit := n >> k
+		n &= mask
+		if w < a.capacity() {
+			a.d[w] = byte(digit + '0')
+			w++
+		} else if digit > 0 {
+			a.trunc = true
+		}
+		n *= 10
+	}
+	a.nd = w
+	a.trim()
+}
+
+// shouldRoundUp reports whether the decimal should be rounded up when keeping nd digits, rounding half to even
+//
+// Parameters:
+//
+//	nd: The number of digits to keep.
+//
+// Returns:
+//
+// True if the kept digits must be incremented.
+func (a *decimal) shouldRoundUp(nd int) bool {
+	if nd < 0 || nd >= a.nd {
+		return false
+	}
+
+	// Exactly halfway, round to even
+	if a.d[nd] == '5' && nd+1 == a.nd {
+		if a.trunc {
+			return true
+		}
+		return nd > 0 && (a.d[nd-1]-'0')%2 != 0
+	}
+	return a.d[nd] >= '5'
+}
+
+// round rounds the decimal to nd digits, half to even
+//
+// Parameters:
+//
+//	nd: The number of digits to keep.
+func (a *decimal) round(nd int) {
+	if a.shouldRoundUp(nd) {
+		a.roundUp(nd)
+	} else {
+		a.roundDown(nd)
+	}
+}
+
+// roundWithMode rounds the decimal to nd digits with the given rounding mode
+//
+// Parameters:
+//
+//	nd: The number of digits to keep. If it is negative, the decimal is smaller than half of the last kept digit and
+//	is left as is.
+//	mode: The rounding mode applied to the dropped digits.
+func (a *decimal) roundWithMode(nd int, mode RoundingMode) {
+	switch {
+	case mode == RoundHalfEven:
+		a.round(nd)
+	case mode == RoundHalfUp && nd >= 0 && nd < a.nd && a.d[nd] >= '5':
+		a.roundUp(nd)
+	default:
+		a.roundDown(nd)
+	}
+}
+
+// roundUp increments the decimal kept to nd digits
+//
+// Parameters:
+//
+//	nd: The number of digits to keep.
+func (a *decimal) roundUp(nd int) {
+	if nd < 0 || nd >= a.nd {
+		return
+	}
+
+	// Find the last digit that is not a 9 and increment it
+	for i := nd - 1; i >= 0; i-- {
+		if a.d[i] < '9' {
+			a.d[i]++
+			a.nd = i + 1
+			return
+		}
+	}
+
+	// All the kept digits are 9s, so the number becomes 10^dp
+	a.d[0] = '1'
+	a.nd = 1
+	a.dp++
+}
+
+// roundDown truncates the decimal to nd digits
+//
+// Parameters:
+//
+//	nd: The number of digits to keep.
+func (a *decimal) roundDown(nd int) {
+	if nd < 0 || nd >= a.nd {
+		return
+	}
+	a.nd = nd
+	a.trim()
+}
+
+// roundedInteger returns the integer part of the decimal, rounded half to even
+//
+// Returns:
+//
+// The rounded integer, or the maximum uint64 if it does not fit.
+func (a *decimal) roundedInteger() uint64 {
+	if a.dp > 20 {
+		return 1<<64 - 1
+	}
+	var n uint64
+	i := 0
+	for ; i < a.dp && i < a.nd; i++ {
+		n = n*10 + uint64(a.d[i]-'0')
+	}
+	for ; i < a.dp; i++ {
+		n *= 10
+	}
+	if a.shouldRoundUp(a.dp) {
+		n++
+	}
+	return n
+}
+
+// float64Bits converts the decimal to the nearest float64 bit pattern, rounding half to even
+//
+// Returns:
+//
+// The float64 bits and whether the value overflowed to infinity.
+func (a *decimal) float64Bits() (uint64, bool) {
+	const (
+		mantBits = 52
+		expBits  = 11
+		bias     = -1023
+	)
+	var exp int
+	var mant uint64
+	overflow := false
+
+	switch {
+	case a.nd == 0 || a.dp < -330:
+		// Zero or too small to be represented
+		exp = bias
+	case a.dp > 310:
+		overflow = true
+	default:
+		// Scale by powers of two until the decimal is in the range [0.5, 1)
+		for a.dp > 0 {
+			n := 27
+			if a.dp < len(decimalPowTab) {
+				n = decimalPowTab[a.dp]
+			}
+			a.shift(-n)
+			exp += n
+		}
+		for a.dp < 0 || a.dp == 0 && a.d[0] < '5' {
+			n := 27
+			if -a.dp < len(decimalPowTab) {
+				n = decimalPowTab[-a.dp]
+			}
+			a.shift(n)
+			exp -= n
+		}
+
+		// The float64 mantissa is in the range [1, 2)
+		exp--
+
+		// Move denormalized values up to the minimum exponent
+		if exp < bias+1 {
+			n := bias + 1 - exp
+			a.shift(-n)
+			exp += n
+		}
+		if exp-bias >= 1<<expBits-1 {
+			overflow = true
+			break
+		}
+
+		// Extract the mantissa bits, rounding may add an extra bit
+		a.shift(1 + mantBits)
+		mant = a.roundedInteger()
+		if mant == 2<<mantBits {
+			mant >>= 1
+			exp++
+			if exp-bias >= 1<<expBits-1 {
+				overflow = true
+				break
+			}
+		}
+
+		// Denormalized values have the minimum exponent
+		if mant&(1<<mantBits) == 0 {
+			exp = bias
+		}
+	}
+	if overflow {
+		mant = 0
+		exp = 1<<expBits - 1 + bias
+	}
+
+	bits := mant & (1<<mantBits - 1)
+	bits |= uint64((exp-bias)&(1<<expBits-1)) << mantBits
+	if a.neg {
+		bits |= 1 << (mantBits + expBits)
+	}
+	return bits, overflow
+}
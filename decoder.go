@@ -0,0 +1,457 @@
+package tinygo_buffers
+
+import (
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+type (
+	// Decoder reads binary fields from a byte slice through a read cursor.
+	//
+	// The first failed read records a sticky error, and every later read returns a zero value without moving the
+	// cursor, so a whole response can be decoded with a single error check at the end. The zero value is a Decoder
+	// with no data.
+	Decoder struct {
+		data   []byte
+		offset int
+		err    tinygoerrors.ErrorCode
+	}
+)
+
+// NewDecoder creates a Decoder that reads from the given byte slice, starting at its first byte
+//
+// Parameters:
+//
+//	data: The bytes to read.
+//
+// Returns:
+//
+// The Decoder.
+func NewDecoder(data []byte) Decoder {
+	return Decoder{data: data}
+}
+
+// Offset returns the number of bytes read or skipped
+//
+// Returns:
+//
+// The position of the cursor.
+func (d *Decoder) Offset() int {
+	return d.offset
+}
+
+// Remaining returns the number of bytes that can still be read
+//
+// Returns:
+//
+// The number of bytes after the cursor.
+func (d *Decoder) Remaining() int {
+	return len(d.data) - d.offset
+}
+
+// Err returns the sticky error
+//
+// Returns:
+//
+// The error of the first failed read, or ErrorCodeNil if every read succeeded.
+func (d *Decoder) Err() tinygoerrors.ErrorCode {
+	return d.err
+}
+
+// Next returns the next bytes and advances the cursor past them
+//
+// Parameters:
+//
+//	size: The number of bytes to read.
+//
+// Returns:
+//
+// The bytes, which alias the data given to NewDecoder, or nil if there is a sticky error or fewer bytes remain, in
+// which case ErrorCodeBuffersInvalidBufferSize is recorded.
+func (d *Decoder) Next(size int) []byte {
+	if d.err != tinygoerrors.ErrorCodeNil {
+		return nil
+	}
+	if size < 0 || size > len(d.data)-d.offset {
+		d.err = ErrorCodeBuffersInvalidBufferSize
+		return nil
+	}
+	data := d.data[d.offset : d.offset+size]
+	d.offset += size
+	return data
+}
+
+// Peek returns the next bytes without advancing the cursor. A short read does not record an error, so it can be used
+// to look ahead at optional fields
+//
+// Parameters:
+//
+//	size: The number of bytes to return.
+//
+// Returns:
+//
+// The bytes, which alias the data given to NewDecoder, or nil if there is a sticky error or fewer bytes remain.
+func (d *Decoder) Peek(size int) []byte {
+	if d.err != tinygoerrors.ErrorCodeNil || size < 0 || size > len(d.data)-d.offset {
+		return nil
+	}
+	return d.data[d.offset : d.offset+size]
+}
+
+// Skip advances the cursor without reading
+//
+// Parameters:
+//
+//	size: The number of bytes to skip.
+func (d *Decoder) Skip(size int) {
+	d.Next(size)
+}
+
+// AlignTo advances the cursor to the next multiple of the given alignment, counted from the start of the data
+//
+// Parameters:
+//
+//	alignment: The alignment in bytes. Values below 2 leave the cursor unchanged.
+func (d *Decoder) AlignTo(alignment int) {
+	if alignment < 2 {
+		return
+	}
+	if padding := d.offset % alignment; padding != 0 {
+		d.Skip(alignment - padding)
+	}
+}
+
+// Uint8 reads an uint8 value
+//
+// Returns:
+//
+// The uint8 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint8() uint8 {
+	if data := d.Next(1); data != nil {
+		return uint8(data[0])
+	}
+	return 0
+}
+
+// Int8 reads an int8 value
+//
+// Returns:
+//
+// The int8 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int8() int8 {
+	if data := d.Next(1); data != nil {
+		return int8(data[0])
+	}
+	return 0
+}
+
+// Uint16 reads an uint16 value in big-endian order
+//
+// Returns:
+//
+// The uint16 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint16() uint16 {
+	if data := d.Next(2); data != nil {
+		value, _ := BytesToUint16(data)
+		return value
+	}
+	return 0
+}
+
+// Uint16LE reads an uint16 value in little-endian order
+//
+// Returns:
+//
+// The uint16 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint16LE() uint16 {
+	if data := d.Next(2); data != nil {
+		value, _ := BytesToUint16LE(data)
+		return value
+	}
+	return 0
+}
+
+// Int16 reads an int16 value in big-endian order
+//
+// Returns:
+//
+// The int16 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int16() int16 {
+	if data := d.Next(2); data != nil {
+		value, _ := BytesToInt16(data)
+		return value
+	}
+	return 0
+}
+
+// Int16LE reads an int16 value in little-endian order
+//
+// Returns:
+//
+// The int16 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int16LE() int16 {
+	if data := d.Next(2); data != nil {
+		value, _ := BytesToInt16LE(data)
+		return value
+	}
+	return 0
+}
+
+// Uint24 reads an uint32 value in big-endian order
+//
+// Returns:
+//
+// The uint32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint24() uint32 {
+	if data := d.Next(3); data != nil {
+		value, _ := BytesToUint24(data)
+		return value
+	}
+	return 0
+}
+
+// Uint24LE reads an uint32 value in little-endian order
+//
+// Returns:
+//
+// The uint32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint24LE() uint32 {
+	if data := d.Next(3); data != nil {
+		value, _ := BytesToUint24LE(data)
+		return value
+	}
+	return 0
+}
+
+// Int24 reads an int32 value in big-endian order, sign-extended from 24 bits
+//
+// Returns:
+//
+// The int32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int24() int32 {
+	if data := d.Next(3); data != nil {
+		value, _ := BytesToInt24(data)
+		return value
+	}
+	return 0
+}
+
+// Int24LE reads an int32 value in little-endian order, sign-extended from 24 bits
+//
+// Returns:
+//
+// The int32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int24LE() int32 {
+	if data := d.Next(3); data != nil {
+		value, _ := BytesToInt24LE(data)
+		return value
+	}
+	return 0
+}
+
+// Uint32 reads an uint32 value in big-endian order
+//
+// Returns:
+//
+// The uint32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint32() uint32 {
+	if data := d.Next(4); data != nil {
+		value, _ := BytesToUint32(data)
+		return value
+	}
+	return 0
+}
+
+// Uint32LE reads an uint32 value in little-endian order
+//
+// Returns:
+//
+// The uint32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint32LE() uint32 {
+	if data := d.Next(4); data != nil {
+		value, _ := BytesToUint32LE(data)
+		return value
+	}
+	return 0
+}
+
+// Int32 reads an int32 value in big-endian order
+//
+// Returns:
+//
+// The int32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int32() int32 {
+	if data := d.Next(4); data != nil {
+		value, _ := BytesToInt32(data)
+		return value
+	}
+	return 0
+}
+
+// Int32LE reads an int32 value in little-endian order
+//
+// Returns:
+//
+// The int32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int32LE() int32 {
+	if data := d.Next(4); data != nil {
+		value, _ := BytesToInt32LE(data)
+		return value
+	}
+	return 0
+}
+
+// Uint48 reads an uint64 value in big-endian order
+//
+// Returns:
+//
+// The uint64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint48() uint64 {
+	if data := d.Next(6); data != nil {
+		value, _ := BytesToUint48(data)
+		return value
+	}
+	return 0
+}
+
+// Uint48LE reads an uint64 value in little-endian order
+//
+// Returns:
+//
+// The uint64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint48LE() uint64 {
+	if data := d.Next(6); data != nil {
+		value, _ := BytesToUint48LE(data)
+		return value
+	}
+	return 0
+}
+
+// Int48 reads an int64 value in big-endian order, sign-extended from 48 bits
+//
+// Returns:
+//
+// The int64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int48() int64 {
+	if data := d.Next(6); data != nil {
+		value, _ := BytesToInt48(data)
+		return value
+	}
+	return 0
+}
+
+// Int48LE reads an int64 value in little-endian order, sign-extended from 48 bits
+//
+// Returns:
+//
+// The int64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int48LE() int64 {
+	if data := d.Next(6); data != nil {
+		value, _ := BytesToInt48LE(data)
+		return value
+	}
+	return 0
+}
+
+// Uint64 reads an uint64 value in big-endian order
+//
+// Returns:
+//
+// The uint64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint64() uint64 {
+	if data := d.Next(8); data != nil {
+		value, _ := BytesToUint64(data)
+		return value
+	}
+	return 0
+}
+
+// Uint64LE reads an uint64 value in little-endian order
+//
+// Returns:
+//
+// The uint64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Uint64LE() uint64 {
+	if data := d.Next(8); data != nil {
+		value, _ := BytesToUint64LE(data)
+		return value
+	}
+	return 0
+}
+
+// Int64 reads an int64 value in big-endian order
+//
+// Returns:
+//
+// The int64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int64() int64 {
+	if data := d.Next(8); data != nil {
+		value, _ := BytesToInt64(data)
+		return value
+	}
+	return 0
+}
+
+// Int64LE reads an int64 value in little-endian order
+//
+// Returns:
+//
+// The int64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Int64LE() int64 {
+	if data := d.Next(8); data != nil {
+		value, _ := BytesToInt64LE(data)
+		return value
+	}
+	return 0
+}
+
+// Float32 reads a float32 value in big-endian order
+//
+// Returns:
+//
+// The float32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Float32() float32 {
+	if data := d.Next(4); data != nil {
+		value, _ := BytesToFloat32(data)
+		return value
+	}
+	return 0
+}
+
+// Float32LE reads a float32 value in little-endian order
+//
+// Returns:
+//
+// The float32 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Float32LE() float32 {
+	if data := d.Next(4); data != nil {
+		value, _ := BytesToFloat32LE(data)
+		return value
+	}
+	return 0
+}
+
+// Float64 reads a float64 value in big-endian order
+//
+// Returns:
+//
+// The float64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Float64() float64 {
+	if data := d.Next(8); data != nil {
+		value, _ := BytesToFloat64(data)
+		return value
+	}
+	return 0
+}
+
+// Float64LE reads a float64 value in little-endian order
+//
+// Returns:
+//
+// The float64 value, or 0 if there is a sticky error or it does not fit in the remaining bytes.
+func (d *Decoder) Float64LE() float64 {
+	if data := d.Next(8); data != nil {
+		value, _ := BytesToFloat64LE(data)
+		return value
+	}
+	return 0
+}
@@ -0,0 +1,217 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// decodeFrame reads the fields written by encodeFrame
+func decodeFrame(d *Decoder) []any {
+	return []any{
+		d.Uint8(),
+		d.Int8(),
+		d.Uint16(),
+		d.Uint16LE(),
+		d.Int16(),
+		d.Int16LE(),
+		d.Uint24(),
+		d.Uint24LE(),
+		d.Int24(),
+		d.Int24LE(),
+		d.Uint32(),
+		d.Uint32LE(),
+		d.Int32(),
+		d.Int32LE(),
+		d.Uint48(),
+		d.Uint48LE(),
+		d.Int48(),
+		d.Int48LE(),
+		d.Uint64(),
+		d.Uint64LE(),
+		d.Int64(),
+		d.Int64LE(),
+		d.Float32(),
+		d.Float32LE(),
+		d.Float64(),
+		d.Float64LE(),
+		string(d.Next(2)),
+	}
+}
+
+// decoderFrameValues are the values read back from encoderFrame, with the bits beyond 24 and 48 dropped
+var decoderFrameValues = []any{
+	uint8(0x01),
+	int8(-2),
+	uint16(0x0203),
+	uint16(0x0405),
+	int16(-2),
+	int16(-2),
+	uint32(0x060708),
+	uint32(0x090A0B),
+	int32(-3),
+	int32(-3),
+	uint32(0x11223344),
+	uint32(0x11223344),
+	int32(-1 << 31),
+	int32(-1 << 31),
+	uint64(0x123456789ABC),
+	uint64(0x123456789ABC),
+	int64(-1<<47 + 1),
+	int64(-1<<47 + 1),
+	uint64(0x0102030405060708),
+	uint64(0x0102030405060708),
+	int64(-4),
+	int64(-4),
+	float32(1),
+	float32(-1.5),
+	3.141592653589793,
+	float64(-1),
+	"hi",
+}
+
+// TestDecoderRoundTrip reads back the frame written by the Encoder
+func TestDecoderRoundTrip(t *testing.T) {
+	buffer := make([]byte, len(encoderFrame))
+	e := NewEncoder(buffer)
+	encodeFrame(&e)
+	d := NewDecoder(e.Bytes())
+	got := decodeFrame(&d)
+	if d.Err() != tinygoerrors.ErrorCodeNil || d.Remaining() != 0 || d.Offset() != len(encoderFrame) {
+		t.Fatalf("Err() = %d, Remaining() = %d, Offset() = %d", d.Err(), d.Remaining(), d.Offset())
+	}
+	for i, want := range decoderFrameValues {
+		if got[i] != want {
+			t.Errorf("field %d = %#v, want %#v", i, got[i], want)
+		}
+	}
+}
+
+// TestDecoderStickyError checks that the first read past the end records the error without moving the cursor, and
+// that every later read returns a zero value even if it would fit
+func TestDecoderStickyError(t *testing.T) {
+	for n := 0; n < len(encoderFrame); n++ {
+		d := NewDecoder(encoderFrame[:n])
+		got := decodeFrame(&d)
+		if d.Err() != ErrorCodeBuffersInvalidBufferSize {
+			t.Fatalf("Err() = %d with %d bytes", d.Err(), n)
+		}
+
+		// The fields before the short read are decoded, the rest are zero
+		offset := d.Offset()
+		failed := false
+		for i, want := range decoderFrameValues {
+			zero := got[i] == decoderZero(want)
+			if !failed && got[i] != want {
+				failed = true
+			}
+			if failed && !zero {
+				t.Fatalf("field %d = %#v with %d bytes, after the short read", i, got[i], n)
+			}
+		}
+		if offset > n || d.Remaining() != n-offset {
+			t.Fatalf("Offset() = %d, Remaining() = %d with %d bytes", offset, d.Remaining(), n)
+		}
+
+		// Later reads do not move the cursor
+		d.Skip(0)
+		if d.Uint8() != 0 || d.Next(0) != nil || d.Peek(0) != nil || d.Offset() != offset {
+			t.Fatalf("read after the error moved the cursor from %d to %d", offset, d.Offset())
+		}
+	}
+
+	// The zero value has no data
+	var d Decoder
+	if d.Uint8() != 0 || d.Err() != ErrorCodeBuffersInvalidBufferSize || d.Offset() != 0 {
+		t.Fatalf("zero Decoder: Err() = %d, Offset() = %d", d.Err(), d.Offset())
+	}
+}
+
+// decoderZero returns the zero value of the type of a decoded field
+func decoderZero(value any) any {
+	switch value.(type) {
+	case uint8:
+		return uint8(0)
+	case int8:
+		return int8(0)
+	case uint16:
+		return uint16(0)
+	case int16:
+		return int16(0)
+	case uint32:
+		return uint32(0)
+	case int32:
+		return int32(0)
+	case uint64:
+		return uint64(0)
+	case int64:
+		return int64(0)
+	case float32:
+		return float32(0)
+	case float64:
+		return float64(0)
+	}
+	return ""
+}
+
+// TestDecoderPeekSkipAlign checks that Peek does not advance nor record errors, Skip past the end, and AlignTo at
+// aligned and unaligned offsets
+func TestDecoderPeekSkipAlign(t *testing.T) {
+	data := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	d := NewDecoder(data)
+
+	if got := d.Peek(2); !bytes.Equal(got, []byte{1, 2}) || d.Offset() != 0 {
+		t.Fatalf("Peek(2) = % X, Offset() = %d", got, d.Offset())
+	}
+	if got := d.Peek(len(data) + 1); got != nil || d.Err() != tinygoerrors.ErrorCodeNil {
+		t.Fatalf("Peek past the end = % X, Err() = %d", got, d.Err())
+	}
+	if got := d.Peek(-1); got != nil || d.Err() != tinygoerrors.ErrorCodeNil {
+		t.Fatalf("Peek(-1) = % X, Err() = %d", got, d.Err())
+	}
+
+	// Aligning an aligned offset does not move the cursor
+	for _, alignment := range []int{-1, 0, 1, 2, 4, 8} {
+		if d.AlignTo(alignment); d.Offset() != 0 {
+			t.Fatalf("AlignTo(%d) at offset 0 moved the cursor to %d", alignment, d.Offset())
+		}
+	}
+	d.Skip(4)
+	d.AlignTo(4)
+	d.AlignTo(2)
+	if d.Offset() != 4 {
+		t.Fatalf("AlignTo at offset 4 moved the cursor to %d", d.Offset())
+	}
+
+	// Aligning an unaligned offset skips the padding
+	d.Skip(1)
+	d.AlignTo(4)
+	if got := d.Uint8(); got != 9 || d.Offset() != 9 || d.Err() != tinygoerrors.ErrorCodeNil {
+		t.Fatalf("Uint8() after AlignTo(4) = %d, Offset() = %d, Err() = %d", got, d.Offset(), d.Err())
+	}
+
+	// Skipping or aligning past the end records the error and keeps the cursor
+	d = NewDecoder(data)
+	d.Skip(len(data) + 1)
+	if d.Err() != ErrorCodeBuffersInvalidBufferSize || d.Offset() != 0 {
+		t.Fatalf("Skip past the end: Err() = %d, Offset() = %d", d.Err(), d.Offset())
+	}
+	d = NewDecoder(data)
+	d.Skip(-1)
+	if d.Err() != ErrorCodeBuffersInvalidBufferSize || d.Offset() != 0 {
+		t.Fatalf("Skip(-1): Err() = %d, Offset() = %d", d.Err(), d.Offset())
+	}
+	d = NewDecoder(data[:8])
+	d.Skip(8)
+	d.AlignTo(4)
+	if d.Err() != tinygoerrors.ErrorCodeNil || d.Remaining() != 0 {
+		t.Fatalf("Skip to the end: Err() = %d, Remaining() = %d", d.Err(), d.Remaining())
+	}
+	d = NewDecoder(data[:6])
+	d.Skip(5)
+	d.AlignTo(8)
+	if d.Err() != ErrorCodeBuffersInvalidBufferSize || d.Offset() != 5 {
+		t.Fatalf("AlignTo past the end: Err() = %d, Offset() = %d", d.Err(), d.Offset())
+	}
+}
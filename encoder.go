@@ -0,0 +1,509 @@
+package tinygo_buffers
+
+import (
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+type (
+	// Encoder writes binary fields to a caller buffer through a write cursor.
+	//
+	// The first failed write records a sticky error and every later write is skipped, so a whole packet can be
+	// encoded with a single error check at the end. A write that does not fit leaves the cursor unchanged. The zero
+	// value is an Encoder with no room.
+	Encoder struct {
+		buffer []byte
+		offset int
+		err    tinygoerrors.ErrorCode
+	}
+
+	// LengthSlot is a length field reserved by an Encoder before the body it measures, and filled once the body is
+	// written
+	LengthSlot struct {
+		offset int
+		size   uint8
+		order  ByteOrder
+	}
+)
+
+// NewEncoder creates an Encoder that writes to the given buffer, starting at its first byte
+//
+// Parameters:
+//
+//	buffer: The buffer to write to.
+//
+// Returns:
+//
+// The Encoder.
+func NewEncoder(buffer []byte) Encoder {
+	return Encoder{buffer: buffer}
+}
+
+// Reset moves the cursor back to the start of the buffer and clears the sticky error
+func (e *Encoder) Reset() {
+	e.offset = 0
+	e.err = tinygoerrors.ErrorCodeNil
+}
+
+// Len returns the number of bytes written
+//
+// Returns:
+//
+// The position of the cursor.
+func (e *Encoder) Len() int {
+	return e.offset
+}
+
+// Remaining returns the number of bytes that can still be written
+//
+// Returns:
+//
+// The number of bytes after the cursor.
+func (e *Encoder) Remaining() int {
+	return len(e.buffer) - e.offset
+}
+
+// Bytes returns the bytes written
+//
+// Returns:
+//
+// The buffer up to the cursor. It aliases the buffer given to NewEncoder.
+func (e *Encoder) Bytes() []byte {
+	return e.buffer[:e.offset]
+}
+
+// Err returns the sticky error
+//
+// Returns:
+//
+// The error of the first failed write, or ErrorCodeNil if every write succeeded.
+func (e *Encoder) Err() tinygoerrors.ErrorCode {
+	return e.err
+}
+
+// next returns the next bytes of the buffer and advances the cursor past them
+//
+// Parameters:
+//
+//	size: The number of bytes to write.
+//
+// Returns:
+//
+// The bytes to write to, or nil if there is a sticky error or they do not fit, in which case
+// ErrorCodeBuffersInvalidBufferSize is recorded.
+func (e *Encoder) next(size int) []byte {
+	if e.err != tinygoerrors.ErrorCodeNil {
+		return nil
+	}
+	if size > len(e.buffer)-e.offset {
+		e.err = ErrorCodeBuffersInvalidBufferSize
+		return nil
+	}
+	buffer := e.buffer[e.offset : e.offset+size]
+	e.offset += size
+	return buffer
+}
+
+// PutBytes writes a byte slice as is
+//
+// Parameters:
+//
+//	data: The bytes to write.
+func (e *Encoder) PutBytes(data []byte) {
+	if buffer := e.next(len(data)); buffer != nil {
+		copy(buffer, data)
+	}
+}
+
+// PutUint8 writes an uint8 value
+//
+// Parameters:
+//
+//	value: The uint8 value to write.
+func (e *Encoder) PutUint8(value uint8) {
+	if buffer := e.next(1); buffer != nil {
+		buffer[0] = byte(value)
+	}
+}
+
+// PutInt8 writes an int8 value
+//
+// Parameters:
+//
+//	value: The int8 value to write.
+func (e *Encoder) PutInt8(value int8) {
+	if buffer := e.next(1); buffer != nil {
+		buffer[0] = byte(value)
+	}
+}
+
+// PutUint16 writes an uint16 value in big-endian order
+//
+// Parameters:
+//
+//	value: The uint16 value to write.
+func (e *Encoder) PutUint16(value uint16) {
+	if buffer := e.next(2); buffer != nil {
+		Uint16ToBytes(value, buffer)
+	}
+}
+
+// PutUint16LE writes an uint16 value in little-endian order
+//
+// Parameters:
+//
+//	value: The uint16 value to write.
+func (e *Encoder) PutUint16LE(value uint16) {
+	if buffer := e.next(2); buffer != nil {
+		Uint16ToBytesLE(value, buffer)
+	}
+}
+
+// PutInt16 writes an int16 value in big-endian order
+//
+// Parameters:
+//
+//	value: The int16 value to write.
+func (e *Encoder) PutInt16(value int16) {
+	if buffer := e.next(2); buffer != nil {
+		Int16ToBytes(value, buffer)
+	}
+}
+
+// PutInt16LE writes an int16 value in little-endian order
+//
+// Parameters:
+//
+//	value: The int16 value to write.
+func (e *Encoder) PutInt16LE(value int16) {
+	if buffer := e.next(2); buffer != nil {
+		Int16ToBytesLE(value, buffer)
+	}
+}
+
+// PutUint24 writes an uint32 value in big-endian order
+//
+// Parameters:
+//
+//	value: The uint32 value to write, only its low 24 bits are written.
+func (e *Encoder) PutUint24(value uint32) {
+	if buffer := e.next(3); buffer != nil {
+		Uint24ToBytes(value, buffer)
+	}
+}
+
+// PutUint24LE writes an uint32 value in little-endian order
+//
+// Parameters:
+//
+//	value: The uint32 value to write, only its low 24 bits are written.
+func (e *Encoder) PutUint24LE(value uint32) {
+	if buffer := e.next(3); buffer != nil {
+		Uint24ToBytesLE(value, buffer)
+	}
+}
+
+// PutInt24 writes an int32 value in big-endian order
+//
+// Parameters:
+//
+//	value: The int32 value to write, only its low 24 bits are written.
+func (e *Encoder) PutInt24(value int32) {
+	if buffer := e.next(3); buffer != nil {
+		Int24ToBytes(value, buffer)
+	}
+}
+
+// PutInt24LE writes an int32 value in little-endian order
+//
+// Parameters:
+//
+//	value: The int32 value to write, only its low 24 bits are written.
+func (e *Encoder) PutInt24LE(value int32) {
+	if buffer := e.next(3); buffer != nil {
+		Int24ToBytesLE(value, buffer)
+	}
+}
+
+// PutUint32 writes an uint32 value in big-endian order
+//
+// Parameters:
+//
+//	value: The uint32 value to write.
+func (e *Encoder) PutUint32(value uint32) {
+	if buffer := e.next(4); buffer != nil {
+		Uint32ToBytes(value, buffer)
+	}
+}
+
+// PutUint32LE writes an uint32 value in little-endian order
+//
+// Parameters:
+//
+//	value: The uint32 value to write.
+func (e *Encoder) PutUint32LE(value uint32) {
+	if buffer := e.next(4); buffer != nil {
+		Uint32ToBytesLE(value, buffer)
+	}
+}
+
+// PutInt32 writes an int32 value in big-endian order
+//
+// Parameters:
+//
+//	value: The int32 value to write.
+func (e *Encoder) PutInt32(value int32) {
+	if buffer := e.next(4); buffer != nil {
+		Int32ToBytes(value, buffer)
+	}
+}
+
+// PutInt32LE writes an int32 value in little-endian order
+//
+// Parameters:
+//
+//	value: The int32 value to write.
+func (e *Encoder) PutInt32LE(value int32) {
+	if buffer := e.next(4); buffer != nil {
+		Int32ToBytesLE(value, buffer)
+	}
+}
+
+// PutUint48 writes an uint64 value in big-endian order
+//
+// Parameters:
+//
+//	value: The uint64 value to write, only its low 48 bits are written.
+func (e *Encoder) PutUint48(value uint64) {
+	if buffer := e.next(6); buffer != nil {
+		Uint48ToBytes(value, buffer)
+	}
+}
+
+// PutUint48LE writes an uint64 value in little-endian order
+//
+// Parameters:
+//
+//	value: The uint64 value to write, only its low 48 bits are written.
+func (e *Encoder) PutUint48LE(value uint64) {
+	if buffer := e.next(6); buffer != nil {
+		Uint48ToBytesLE(value, buffer)
+	}
+}
+
+// PutInt48 writes an int64 value in big-endian order
+//
+// Parameters:
+//
+//	value: The int64 value to write, only its low 48 bits are written.
+func (e *Encoder) PutInt48(value int64) {
+	if buffer := e.next(6); buffer != nil {
+		Int48ToBytes(value, buffer)
+	}
+}
+
+// PutInt48LE writes an int64 value in little-endian order
+//
+// Parameters:
+//
+//	value: The int64 value to write, only its low 48 bits are written.
+func (e *Encoder) PutInt48LE(value int64) {
+	if buffer := e.next(6); buffer != nil {
+		Int48ToBytesLE(value, buffer)
+	}
+}
+
+// PutUint64 writes an uint64 value in big-endian order
+//
+// Parameters:
+//
+//	value: The uint64 value to write.
+func (e *Encoder) PutUint64(value uint64) {
+	if buffer := e.next(8); buffer != nil {
+		Uint64ToBytes(value, buffer)
+	}
+}
+
+// PutUint64LE writes an uint64 value in little-endian order
+//
+// Parameters:
+//
+//	value: The uint64 value to write.
+func (e *Encoder) PutUint64LE(value uint64) {
+	if buffer := e.next(8); buffer != nil {
+		Uint64ToBytesLE(value, buffer)
+	}
+}
+
+// PutInt64 writes an int64 value in big-endian order
+//
+// Parameters:
+//
+//	value: The int64 value to write.
+func (e *Encoder) PutInt64(value int64) {
+	if buffer := e.next(8); buffer != nil {
+		Int64ToBytes(value, buffer)
+	}
+}
+
+// PutInt64LE writes an int64 value in little-endian order
+//
+// Parameters:
+//
+//	value: The int64 value to write.
+func (e *Encoder) PutInt64LE(value int64) {
+	if buffer := e.next(8); buffer != nil {
+		Int64ToBytesLE(value, buffer)
+	}
+}
+
+// PutFloat32 writes a float32 value in big-endian order
+//
+// Parameters:
+//
+//	value: The float32 value to write.
+func (e *Encoder) PutFloat32(value float32) {
+	if buffer := e.next(4); buffer != nil {
+		Float32ToBytes(value, buffer)
+	}
+}
+
+// PutFloat32LE writes a float32 value in little-endian order
+//
+// Parameters:
+//
+//	value: The float32 value to write.
+func (e *Encoder) PutFloat32LE(value float32) {
+	if buffer := e.next(4); buffer != nil {
+		Float32ToBytesLE(value, buffer)
+	}
+}
+
+// PutFloat64 writes a float64 value in big-endian order
+//
+// Parameters:
+//
+//	value: The float64 value to write.
+func (e *Encoder) PutFloat64(value float64) {
+	if buffer := e.next(8); buffer != nil {
+		Float64ToBytes(value, buffer)
+	}
+}
+
+// PutFloat64LE writes a float64 value in little-endian order
+//
+// Parameters:
+//
+//	value: The float64 value to write.
+func (e *Encoder) PutFloat64LE(value float64) {
+	if buffer := e.next(8); buffer != nil {
+		Float64ToBytesLE(value, buffer)
+	}
+}
+
+// BeginLength8 reserves an uint8 length slot at the cursor, to be filled by EndLength once the body is written
+//
+// Returns:
+//
+// The slot, which is ignored by EndLength if it could not be reserved.
+func (e *Encoder) BeginLength8() LengthSlot {
+	return e.beginLength(1, BigEndian)
+}
+
+// BeginLength16 reserves an uint16 length slot in big-endian order at the cursor, to be filled by EndLength once the
+// body is written
+//
+// Returns:
+//
+// The slot, which is ignored by EndLength if it could not be reserved.
+func (e *Encoder) BeginLength16() LengthSlot {
+	return e.beginLength(2, BigEndian)
+}
+
+// BeginLength16LE reserves an uint16 length slot in little-endian order at the cursor, to be filled by EndLength once
+// the body is written
+//
+// Returns:
+//
+// The slot, which is ignored by EndLength if it could not be reserved.
+func (e *Encoder) BeginLength16LE() LengthSlot {
+	return e.beginLength(2, LittleEndian)
+}
+
+// BeginLength32 reserves an uint32 length slot in big-endian order at the cursor, to be filled by EndLength once the
+// body is written
+//
+// Returns:
+//
+// The slot, which is ignored by EndLength if it could not be reserved.
+func (e *Encoder) BeginLength32() LengthSlot {
+	return e.beginLength(4, BigEndian)
+}
+
+// BeginLength32LE reserves an uint32 length slot in little-endian order at the cursor, to be filled by EndLength once
+// the body is written
+//
+// Returns:
+//
+// The slot, which is ignored by EndLength if it could not be reserved.
+func (e *Encoder) BeginLength32LE() LengthSlot {
+	return e.beginLength(4, LittleEndian)
+}
+
+// beginLength reserves a length slot at the cursor
+//
+// Parameters:
+//
+//	size: The size of the slot in bytes (1, 2, or 4).
+//	order: The byte order of the slot, BigEndian or LittleEndian.
+//
+// Returns:
+//
+// The slot, with a zero size if it could not be reserved.
+func (e *Encoder) beginLength(size int, order ByteOrder) LengthSlot {
+	offset := e.offset
+	if e.next(size) == nil {
+		return LengthSlot{}
+	}
+	return LengthSlot{offset: offset, size: uint8(size), order: order}
+}
+
+// EndLength fills a length slot with the number of bytes written after it. Slots can be nested, as long as each one
+// is ended after the slots begun inside its body
+//
+// Parameters:
+//
+//	slot: The slot returned by one of the BeginLength methods.
+func (e *Encoder) EndLength(slot LengthSlot) {
+	e.EndCount(slot, e.offset-slot.offset-int(slot.size))
+}
+
+// EndCount fills a length slot with the given value, for protocols that prefix the body with a number of items
+// instead of its size
+//
+// Parameters:
+//
+//	slot: The slot returned by one of the BeginLength methods.
+//	count: The value to store, which must fit in the slot or ErrorCodeBuffersValueOverflow is recorded.
+func (e *Encoder) EndCount(slot LengthSlot, count int) {
+	if e.err != tinygoerrors.ErrorCodeNil || slot.size == 0 {
+		return
+	}
+	if count < 0 || uint64(count) >= 1<<(8*uint64(slot.size)) {
+		e.err = ErrorCodeBuffersValueOverflow
+		return
+	}
+
+	buffer := e.buffer[slot.offset:]
+	switch {
+	case slot.size == 1:
+		buffer[0] = byte(count)
+	case slot.size == 2 && slot.order == LittleEndian:
+		Uint16ToBytesLE(uint16(count), buffer)
+	case slot.size == 2:
+		Uint16ToBytes(uint16(count), buffer)
+	case slot.order == LittleEndian:
+		Uint32ToBytesLE(uint32(count), buffer)
+	default:
+		Uint32ToBytes(uint32(count), buffer)
+	}
+}
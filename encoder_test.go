@@ -0,0 +1,262 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// encoderFrame is the expected output of encodeFrame, one line per field
+var encoderFrame = []byte{
+	0x01,
+	0xFE,
+	0x02, 0x03,
+	0x05, 0x04,
+	0xFF, 0xFE,
+	0xFE, 0xFF,
+	0x06, 0x07, 0x08,
+	0x0B, 0x0A, 0x09,
+	0xFF, 0xFF, 0xFD,
+	0xFD, 0xFF, 0xFF,
+	0x11, 0x22, 0x33, 0x44,
+	0x44, 0x33, 0x22, 0x11,
+	0x80, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x80,
+	0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC,
+	0xBC, 0x9A, 0x78, 0x56, 0x34, 0x12,
+	0x80, 0x00, 0x00, 0x00, 0x00, 0x01,
+	0x01, 0x00, 0x00, 0x00, 0x00, 0x80,
+	0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+	0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFC,
+	0xFC, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x3F, 0x80, 0x00, 0x00,
+	0x00, 0x00, 0xC0, 0xBF,
+	0x40, 0x09, 0x21, 0xFB, 0x54, 0x44, 0x2D, 0x18,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xF0, 0xBF,
+	'h', 'i',
+}
+
+// encodeFrame writes one field of every width, order and signedness
+func encodeFrame(e *Encoder) {
+	e.PutUint8(0x01)
+	e.PutInt8(-2)
+	e.PutUint16(0x0203)
+	e.PutUint16LE(0x0405)
+	e.PutInt16(-2)
+	e.PutInt16LE(-2)
+	e.PutUint24(0xFF060708)
+	e.PutUint24LE(0x090A0B)
+	e.PutInt24(-3)
+	e.PutInt24LE(-3)
+	e.PutUint32(0x11223344)
+	e.PutUint32LE(0x11223344)
+	e.PutInt32(-1 << 31)
+	e.PutInt32LE(-1 << 31)
+	e.PutUint48(0xFFFF123456789ABC)
+	e.PutUint48LE(0x123456789ABC)
+	e.PutInt48(-1<<47 + 1)
+	e.PutInt48LE(-1<<47 + 1)
+	e.PutUint64(0x0102030405060708)
+	e.PutUint64LE(0x0102030405060708)
+	e.PutInt64(-4)
+	e.PutInt64LE(-4)
+	e.PutFloat32(1)
+	e.PutFloat32LE(-1.5)
+	e.PutFloat64(3.141592653589793)
+	e.PutFloat64LE(-1)
+	e.PutBytes([]byte("hi"))
+}
+
+// TestEncoderFrame checks a frame with every field type byte for byte, in a buffer of the exact size
+func TestEncoderFrame(t *testing.T) {
+	buffer := make([]byte, len(encoderFrame))
+	e := NewEncoder(buffer)
+	encodeFrame(&e)
+	if err := e.Err(); err != tinygoerrors.ErrorCodeNil {
+		t.Fatalf("Err() = %d after encoding the frame", err)
+	}
+	if !bytes.Equal(e.Bytes(), encoderFrame) {
+		t.Fatalf("Bytes() = % X, want % X", e.Bytes(), encoderFrame)
+	}
+	if e.Len() != len(encoderFrame) || e.Remaining() != 0 {
+		t.Fatalf("Len() = %d, Remaining() = %d, want %d, 0", e.Len(), e.Remaining(), len(encoderFrame))
+	}
+
+	// Reset starts the same frame over the previous bytes
+	e.Reset()
+	if e.Len() != 0 || e.Remaining() != len(buffer) {
+		t.Fatalf("Len() = %d, Remaining() = %d after Reset", e.Len(), e.Remaining())
+	}
+	encodeFrame(&e)
+	if e.Err() != tinygoerrors.ErrorCodeNil || !bytes.Equal(e.Bytes(), encoderFrame) {
+		t.Fatalf("Bytes() = % X, %d after Reset, want % X", e.Bytes(), e.Err(), encoderFrame)
+	}
+}
+
+// TestEncoderStickyError checks that the first write that does not fit records the error and leaves the cursor
+// unchanged, and that every later write is skipped even if it would fit
+func TestEncoderStickyError(t *testing.T) {
+	for n := 0; n < len(encoderFrame); n++ {
+		// A guard byte after the buffer must never be written
+		buffer := make([]byte, n+1)
+		buffer[n] = 0xA5
+		e := NewEncoder(buffer[:n])
+		encodeFrame(&e)
+		if err := e.Err(); err != ErrorCodeBuffersInvalidBufferSize {
+			t.Fatalf("Err() = %d with a %d byte buffer", err, n)
+		}
+
+		// The cursor stops after the last field that fit whole
+		written := e.Len()
+		if written > n || !bytes.Equal(e.Bytes(), encoderFrame[:written]) {
+			t.Fatalf("Bytes() = % X with a %d byte buffer, want a prefix of the frame", e.Bytes(), n)
+		}
+		if e.Remaining() != n-written || buffer[n] != 0xA5 {
+			t.Fatalf("Remaining() = %d, guard %#x with a %d byte buffer", e.Remaining(), buffer[n], n)
+		}
+
+		// Later writes are no-ops
+		e.PutBytes(nil)
+		e.PutUint8(0xEE)
+		if e.Len() != written || e.Err() != ErrorCodeBuffersInvalidBufferSize {
+			t.Fatalf("Len() = %d, Err() = %d after writing past the error, want %d", e.Len(), e.Err(), written)
+		}
+		for _, c := range buffer[written:n] {
+			if c != 0 {
+				t.Fatalf("write after the error changed the buffer: % X", buffer)
+			}
+		}
+	}
+
+	// The zero value has no room
+	var e Encoder
+	e.PutUint8(1)
+	if e.Err() != ErrorCodeBuffersInvalidBufferSize || e.Len() != 0 || len(e.Bytes()) != 0 {
+		t.Fatalf("zero Encoder: Err() = %d, Len() = %d", e.Err(), e.Len())
+	}
+}
+
+// TestEncoderLengthSlots checks nested slots of every size and order, and a count slot
+func TestEncoderLengthSlots(t *testing.T) {
+	buffer := make([]byte, 32)
+	e := NewEncoder(buffer)
+	outer := e.BeginLength32()
+	e.PutUint8(0xAA)
+	inner16 := e.BeginLength16LE()
+	inner8 := e.BeginLength8()
+	e.PutBytes([]byte{1, 2, 3})
+	e.EndLength(inner8)
+	count := e.BeginLength16()
+	e.PutUint16(0xBBBB)
+	e.PutUint16(0xCCCC)
+	e.EndCount(count, 2)
+	e.EndLength(inner16)
+	inner32 := e.BeginLength32LE()
+	e.EndLength(inner32)
+	e.EndLength(outer)
+
+	want := []byte{
+		0x00, 0x00, 0x00, 0x14,
+		0xAA,
+		0x0A, 0x00,
+		0x03, 0x01, 0x02, 0x03,
+		0x00, 0x02, 0xBB, 0xBB, 0xCC, 0xCC,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	want[3] = byte(len(want) - 4)
+	if e.Err() != tinygoerrors.ErrorCodeNil || !bytes.Equal(e.Bytes(), want) {
+		t.Fatalf("Bytes() = % X, %d, want % X", e.Bytes(), e.Err(), want)
+	}
+}
+
+// TestEncoderLengthOverflow checks the largest length of each slot size and one more, which records
+// ErrorCodeBuffersValueOverflow and leaves the slot unwritten
+func TestEncoderLengthOverflow(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		begin func(*Encoder) LengthSlot
+		max   int
+	}{
+		{"BeginLength8", (*Encoder).BeginLength8, 1<<8 - 1},
+		{"BeginLength16", (*Encoder).BeginLength16, 1<<16 - 1},
+		{"BeginLength16LE", (*Encoder).BeginLength16LE, 1<<16 - 1},
+	} {
+		for _, length := range []int{test.max, test.max + 1} {
+			e := NewEncoder(make([]byte, 2+length))
+			slot := test.begin(&e)
+			e.PutBytes(make([]byte, length))
+			e.EndLength(slot)
+			if length > test.max {
+				if e.Err() != ErrorCodeBuffersValueOverflow || e.Bytes()[0] != 0 {
+					t.Errorf("%s with a %d byte body: Err() = %d, slot % X", test.name, length, e.Err(), e.Bytes()[:2])
+				}
+				continue
+			}
+			if e.Err() != tinygoerrors.ErrorCodeNil || e.Bytes()[0] != 0xFF {
+				t.Errorf("%s with a %d byte body: Err() = %d, slot % X", test.name, length, e.Err(), e.Bytes()[:2])
+			}
+		}
+	}
+
+	// Counts are checked against the slot size too, and cannot be negative
+	for _, count := range []int{-1, 1 << 16} {
+		e := NewEncoder(make([]byte, 2))
+		e.EndCount(e.BeginLength16(), count)
+		if e.Err() != ErrorCodeBuffersValueOverflow || !bytes.Equal(e.Bytes(), []byte{0, 0}) {
+			t.Errorf("EndCount(%d) on a 16-bit slot: Err() = %d, slot % X", count, e.Err(), e.Bytes())
+		}
+	}
+	e := NewEncoder(make([]byte, 8))
+	e.EndCount(e.BeginLength32(), 0x01020304)
+	e.EndCount(e.BeginLength32LE(), 0x01020304)
+	if want := []byte{1, 2, 3, 4, 4, 3, 2, 1}; e.Err() != tinygoerrors.ErrorCodeNil || !bytes.Equal(e.Bytes(), want) {
+		t.Errorf("EndCount on 32-bit slots: Err() = %d, slots % X, want % X", e.Err(), e.Bytes(), want)
+	}
+}
+
+// TestEncoderLengthAfterError checks slots begun after the sticky error is set and slots whose body does not fit,
+// which must not be written nor replace the first error
+func TestEncoderLengthAfterError(t *testing.T) {
+	// A slot that does not fit records the error itself
+	e := NewEncoder(make([]byte, 1))
+	slot := e.BeginLength16()
+	if e.Err() != ErrorCodeBuffersInvalidBufferSize || e.Len() != 0 {
+		t.Fatalf("BeginLength16 in a 1 byte buffer: Err() = %d, Len() = %d", e.Err(), e.Len())
+	}
+	e.EndLength(slot)
+	if e.Err() != ErrorCodeBuffersInvalidBufferSize {
+		t.Fatalf("EndLength of an unreserved slot replaced the error with %d", e.Err())
+	}
+
+	// A slot begun after the error is not reserved, even if it fits
+	buffer := make([]byte, 4)
+	e = NewEncoder(buffer)
+	e.PutUint32(0)
+	e.PutUint8(1)
+	e.Reset()
+	e.PutBytes(make([]byte, 5))
+	slot = e.BeginLength8()
+	e.EndCount(slot, 0x7F)
+	if e.Len() != 0 || e.Err() != ErrorCodeBuffersInvalidBufferSize || buffer[0] != 0 {
+		t.Fatalf("slot begun after the error: Len() = %d, Err() = %d, buffer % X", e.Len(), e.Err(), buffer)
+	}
+
+	// A body that runs past the buffer leaves its slot unwritten and keeps the error of the body
+	e = NewEncoder(buffer)
+	slot = e.BeginLength8()
+	e.PutUint16(0x1234)
+	e.PutUint16(0x5678)
+	e.EndLength(slot)
+	if e.Err() != ErrorCodeBuffersInvalidBufferSize || !bytes.Equal(buffer, []byte{0, 0x12, 0x34, 0}) {
+		t.Fatalf("body past the buffer: Err() = %d, buffer % X", e.Err(), buffer)
+	}
+
+	// A slot kept across Reset lies past the cursor, so its length is negative
+	e.Reset()
+	e.EndLength(slot)
+	if e.Err() != ErrorCodeBuffersValueOverflow {
+		t.Fatalf("EndLength of a slot past the cursor: Err() = %d", e.Err())
+	}
+}
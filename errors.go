@@ -11,5 +11,25 @@ const (
 
 const (
 	ErrorCodeBuffersInvalidBufferSize tinygoerrors.ErrorCode = ErrorCodeBuffersStartNumber + iota
-	ErrorCodeBuffersTooMuchPrecisionDigitsForFloat64
+	ErrorCodeBuffersTooMuchPrecisionDigits
+	ErrorCodeBuffersEmptyInput
+	ErrorCodeBuffersInvalidDigit
+	ErrorCodeBuffersValueOverflow
+	ErrorCodeBuffersTooManyIntegerDigits
+	ErrorCodeBuffersInvalidSeparator
+	ErrorCodeBuffersIncompleteHexByte
+	ErrorCodeBuffersInvalidBase
+	ErrorCodeBuffersInvalidByteOrder
+)
+
+const (
+	// ErrorCodeBuffersTooMuchPrecisionDigitsForFloat64 is the previous name of ErrorCodeBuffersTooMuchPrecisionDigits
+	//
+	// Deprecated: The float32 formatters return the same code. Use ErrorCodeBuffersTooMuchPrecisionDigits instead.
+	ErrorCodeBuffersTooMuchPrecisionDigitsForFloat64 = ErrorCodeBuffersTooMuchPrecisionDigits
+
+	// ErrorCodeBuffersTooManyIntegerDigitsForFloat64 is the previous name of ErrorCodeBuffersTooManyIntegerDigits
+	//
+	// Deprecated: The float32 formatters return the same code. Use ErrorCodeBuffersTooManyIntegerDigits instead.
+	ErrorCodeBuffersTooManyIntegerDigitsForFloat64 = ErrorCodeBuffersTooManyIntegerDigits
 )
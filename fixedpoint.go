@@ -0,0 +1,91 @@
+package tinygo_buffers
+
+import (
+	"math"
+)
+
+// AppendFloat64FixedPoint appends the decimal representation of a float64 value with specified precision to the given
+// byte slice, rounding half to even and using only integer arithmetic
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendFloat64FixedPoint(dst []byte, value float64, precision int) []byte {
+	return AppendFloat64RoundedFixedPoint(dst, value, precision, RoundHalfEven)
+}
+
+// AppendFloat64RoundedFixedPoint appends the decimal representation of a float64 value with specified precision and
+// rounding mode to the given byte slice, using only integer arithmetic
+//
+// The IEEE-754 bits of the value are expanded into their exact decimal digits, so no floating point operation is
+// made and the digits are exact at any precision. It is meant for cores without a double-precision FPU, where every
+// floating point operation is a soft-float call. Building with the tinygo_buffers_nofpu tag makes it the formatter
+// used by AppendFloat64, Float64ToDecimal and their Rounded variants. The output format is the same as
+// AppendFloat64Rounded, and the digits are those of strconv.FormatFloat(value, 'f', precision, 64) when rounding half
+// to even. Both paths are exact, so the build tag only changes the arithmetic and never the output.
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// The extended byte slice. NaN and infinities are appended as NaNBuffer, PositiveInfBuffer and NegativeInfBuffer. No
+// allocation is made if dst has enough capacity.
+func AppendFloat64RoundedFixedPoint(dst []byte, value float64, precision int, mode RoundingMode) []byte {
+	bits := math.Float64bits(value)
+
+	// Check for the special values
+	if bits&float64ExponentMask == float64ExponentMask {
+		switch {
+		case bits&(1<<52-1) != 0:
+			return append(dst, NaNBuffer...)
+		case bits>>63 != 0:
+			return append(dst, NegativeInfBuffer...)
+		}
+		return append(dst, PositiveInfBuffer...)
+	}
+	return appendFloatRoundedExact(dst, bits, &float64Info, precision, mode)
+}
+
+// appendFloatRoundedExact appends the decimal representation of a finite binary floating point number with specified
+// precision and rounding mode, expanding its exact decimal digits
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	bits: The bits of the floating point number, which must be finite.
+//	flt: The layout of the floating point format.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// The extended byte slice.
+func appendFloatRoundedExact(dst []byte, bits uint64, flt *floatInfo, precision int, mode RoundingMode) []byte {
+	if precision < 0 {
+		precision = 0
+	}
+
+	// Expand the exact digits, which keep the sign bit, and round them to the precision
+	var storage [decimalFormatDigits + decimalShiftSlack]byte
+	d := decimal{d: storage[:]}
+	d.assignFloat(bits, flt)
+	d.roundWithMode(d.dp+precision, mode)
+	dst = d.appendFixed(dst, precision)
+
+	// Keep the dot of the floating point formatter when there are no fractional digits
+	if precision == 0 {
+		dst = append(dst, '.')
+	}
+	return dst
+}
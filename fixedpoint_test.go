@@ -0,0 +1,50 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestFloat64PathsAgree runs the floating point and the integer arithmetic paths over the same values, in every
+// rounding mode, and checks that the tinygo_buffers_nofpu tag cannot change the output
+func TestFloat64PathsAgree(t *testing.T) {
+	// The fixed values run with every precision, the random values appended after them with a sample of precisions
+	fixedValues := []float64{
+		0, math.Copysign(0, -1), 0.245, 0.925, 1.865, 0.255, 1.575, 1.895, 0.5, 1.5, 2.5, 0.05, 0.005, 9.995, 99.5,
+		1e-5, 1e-300, 5e-324, 0.1, 1.0 / 3, 123456.789, 1 << 53, 1e19, 1 << 64, 1e20, math.MaxFloat64,
+		math.Inf(1), math.Inf(-1), math.NaN(),
+	}
+	values := append([]float64(nil), fixedValues...)
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		switch i % 3 {
+		case 0:
+			values = append(values, float64(rng.Int63n(10000000))/10000)
+		case 1:
+			values = append(values, rng.Float64()*math.Pow(10, float64(rng.Intn(40)-20)))
+		default:
+			values = append(values, math.Float64frombits(rng.Uint64()))
+		}
+	}
+
+	for i, value := range values {
+		for _, value := range []float64{value, -value} {
+			for precision := 0; precision <= 9; precision++ {
+				if i >= len(fixedValues) && (i+precision)%4 != 0 {
+					continue
+				}
+				for _, mode := range []RoundingMode{RoundHalfEven, RoundHalfUp, RoundTruncate} {
+					float := string(appendFloat64RoundedFloat(nil, value, precision, mode))
+					fixed := string(AppendFloat64RoundedFixedPoint(nil, value, precision, mode))
+					if float != fixed {
+						t.Fatalf(
+							"value %v, precision %d, mode %d: floating point path %q, fixed point path %q",
+							value, precision, mode, float, fixed,
+						)
+					}
+				}
+			}
+		}
+	}
+}
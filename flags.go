@@ -0,0 +1,23 @@
+package tinygo_buffers
+
+type (
+	// FormatFlags selects optional features of the integer formatters. Flags can be combined with a bitwise or, and
+	// the zero value keeps the default output
+	FormatFlags uint8
+)
+
+const (
+	// FormatLowercase writes the letter digits in lowercase, like "ff" instead of "FF"
+	FormatLowercase FormatFlags = 1 << iota
+
+	// FormatPrefix writes the prefix of the base before the digits, like HexPrefix "0x"
+	FormatPrefix
+
+	// FormatTrimLeadingZeros omits the leading zeros instead of padding to the full width of the type, keeping at
+	// least one digit
+	FormatTrimLeadingZeros
+
+	// FormatGroupDigits separates groups of four digits with an underscore, counted from the least significant digit,
+	// like "0b0010_1100". It is supported by the binary, octal and radix formatters
+	FormatGroupDigits
+)
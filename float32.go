@@ -0,0 +1,258 @@
+package tinygo_buffers
+
+import (
+	"math"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+const (
+	// float32Uint32Limit is the smallest float32 whose integer part does not fit in an uint32
+	float32Uint32Limit = 1 << 32
+
+	// float32FractionBits is the number of fractional bits of the fixed point used for the float32 fractional digits,
+	// leaving room to multiply by 10 in an uint32
+	float32FractionBits = 28
+)
+
+// AppendFloat32 appends the decimal representation of a float32 value with specified precision to the given byte slice,
+// rounding half to even
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float32 value to convert.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// The extended byte slice. NaN and infinities are appended as NaNBuffer, PositiveInfBuffer and NegativeInfBuffer. No
+// allocation is made if dst has enough capacity.
+func AppendFloat32(dst []byte, value float32, precision int) []byte {
+	return AppendFloat32Rounded(dst, value, precision, RoundHalfEven)
+}
+
+// AppendFloat32Rounded appends the decimal representation of a float32 value with specified precision and rounding mode
+// to the given byte slice
+//
+// Values not smaller than 2^-5 only use float32 and uint32 arithmetic, so they run on single-precision FPUs without
+// soft-float routines. Smaller values carry their mantissa in a wider fixed point, a pair of uint32 words on 32-bit
+// cores, and values below 2^-37 expand their exact decimal digits. The digits are those of
+// strconv.FormatFloat(float64(value), 'f', precision, 32) when rounding half to even.
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float32 value to convert.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// The extended byte slice. NaN and infinities are appended as NaNBuffer, PositiveInfBuffer and NegativeInfBuffer. No
+// allocation is made if dst has enough capacity.
+func AppendFloat32Rounded(dst []byte, value float32, precision int, mode RoundingMode) []byte {
+	bits := math.Float32bits(value)
+
+	// Check for the special values
+	if bits&0x7F800000 == 0x7F800000 {
+		switch {
+		case bits&0x007FFFFF != 0:
+			return append(dst, NaNBuffer...)
+		case bits&0x80000000 != 0:
+			return append(dst, NegativeInfBuffer...)
+		}
+		return append(dst, PositiveInfBuffer...)
+	}
+
+	// Nonzero values below 2^-37 have fractional bits beyond the 60-bit fixed point, so their exact digits are expanded
+	mant, exp := floatParts(uint64(bits), &float32Info)
+	fractionBits := int(float32Info.mantBits) - exp
+	if fractionBits > float64FractionBits && mant != 0 {
+		return appendFloatRoundedExact(dst, uint64(bits), &float32Info, precision, mode)
+	}
+
+	// Use the sign bit, so negative fractions and negative zero keep their sign
+	if bits&0x80000000 != 0 {
+		dst = append(dst, '-')
+	}
+	bits &^= 0x80000000
+	value = math.Float32frombits(bits)
+	start := len(dst)
+
+	// Convert integer part and add dot
+	var fracPart float32
+	if value < float32Uint32Limit {
+		intPart := uint32(value)
+		fracPart = value - float32(intPart)
+		dst = AppendUintDecimal(dst, uint64(intPart))
+	} else {
+		dst = appendFloatInteger(dst, uint64(bits), &float32Info)
+	}
+	dst = append(dst, '.')
+
+	// Scale the fractional part to 4.28 fixed point, which is exact for every value not smaller than 2^-5
+	scaled := fracPart * (1 << float32FractionBits)
+	fixed := uint32(scaled)
+	if scaled != float32(fixed) {
+		// Smaller values have no integer part, so their mantissa is the fraction in a wider fixed point
+		return mode.appendFractionDigits(dst, start, mant, uint(fractionBits), precision)
+	}
+
+	// Convert fractional part
+	for i := 0; i < precision; i++ {
+		fixed *= 10
+		dst = append(dst, byte('0'+fixed>>float32FractionBits))
+		fixed &= 1<<float32FractionBits - 1
+	}
+
+	// Round the last digit with the remaining fraction, which is exact so ties are detected
+	half := 0
+	switch {
+	case fixed > 1<<(float32FractionBits-1):
+		half = 1
+	case fixed < 1<<(float32FractionBits-1):
+		half = -1
+	}
+	return mode.roundDigits(dst, start, half)
+}
+
+// float32DecimalSize returns the number of bytes AppendFloat32 appends for the given value and precision, without the
+// extra integer digit a rounding carry may add
+//
+// Parameters:
+//
+//	value: The float32 value to convert.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// The size in bytes of the decimal representation, or -1 if the integer part does not fit in an uint64.
+func float32DecimalSize(value float32, precision int) int {
+	bits := math.Float32bits(value)
+	if bits&0x7F800000 == 0x7F800000 {
+		if bits&0x007FFFFF != 0 {
+			return len(NaNBuffer)
+		}
+		return len(PositiveInfBuffer)
+	}
+
+	size := 1
+	if bits&0x80000000 != 0 {
+		size++
+	}
+	value = math.Float32frombits(bits &^ 0x80000000)
+
+	// Values beyond the uint32 range have no fractional part, so their integer part is the mantissa shifted left,
+	// which avoids the soft-float conversion to uint64
+	var buffer [UintToDecimalBufferSize]byte
+	mant, exp := floatParts(uint64(bits), &float32Info)
+	switch shift := exp - int(float32Info.mantBits); {
+	case value < float32Uint32Limit:
+		size += len(AppendUintDecimal(buffer[:0], uint64(uint32(value))))
+	case shift < 64-int(float32Info.mantBits):
+		size += len(AppendUintDecimal(buffer[:0], mant<<shift))
+	default:
+		return -1
+	}
+	if precision > 0 {
+		size += precision
+	}
+	return size
+}
+
+// Float32ToDecimal converts a float32 value to its decimal representation with specified precision, rounding half to
+// even
+//
+// Parameters:
+//
+//	value: The float32 value to convert.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the float32 value and an error code indicating success or
+// failure. If the integer part does not fit in the buffer, ErrorCodeBuffersTooManyIntegerDigits is returned.
+func (f *Formatter) Float32ToDecimal(value float32, precision int) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	return f.Float32ToDecimalRounded(value, precision, RoundHalfEven)
+}
+
+// Float32ToDecimalRounded converts a float32 value to its decimal representation with specified precision and rounding
+// mode
+//
+// Parameters:
+//
+//	value: The float32 value to convert.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the float32 value and an error code indicating success or
+// failure. If the integer part does not fit in the buffer, ErrorCodeBuffersTooManyIntegerDigits is returned.
+func (f *Formatter) Float32ToDecimalRounded(value float32, precision int, mode RoundingMode) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	if precision < 0 {
+		precision = 0
+	}
+
+	// Check the buffer limit before converting
+	size := float32DecimalSize(value, precision)
+	if size < 0 || size-precision > Float64ToDecimalBufferSize {
+		return nil, ErrorCodeBuffersTooManyIntegerDigits
+	}
+	if size > Float64ToDecimalBufferSize {
+		return nil, ErrorCodeBuffersTooMuchPrecisionDigits
+	}
+
+	// The buffer has room for the extra integer digit a rounding carry may add
+	buffer := AppendFloat32Rounded(f.floatToDecimalBuffer[:0], value, precision, mode)
+	if len(buffer) > Float64ToDecimalBufferSize {
+		return nil, ErrorCodeBuffersTooManyIntegerDigits
+	}
+	return buffer, tinygoerrors.ErrorCodeNil
+}
+
+// Float32ToDecimal converts a float32 value to its decimal representation with specified precision, rounding half to
+// even
+//
+// Parameters:
+//
+//	value: The float32 value to convert.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the float32 value and an error code indicating success or
+// failure.
+func Float32ToDecimal(value float32, precision int) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	return defaultFormatter.Float32ToDecimal(value, precision)
+}
+
+// Float32ToDecimalRounded converts a float32 value to its decimal representation with specified precision and rounding
+// mode
+//
+// Parameters:
+//
+//	value: The float32 value to convert.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the float32 value and an error code indicating success or
+// failure.
+func Float32ToDecimalRounded(value float32, precision int, mode RoundingMode) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	return defaultFormatter.Float32ToDecimalRounded(value, precision, mode)
+}
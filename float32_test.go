@@ -0,0 +1,136 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// float32Want returns the expected output of AppendFloat32, which keeps the dot when there are no fractional digits
+func float32Want(value float32, precision int) string {
+	want := strconv.FormatFloat(float64(value), 'f', precision, 32)
+	if precision == 0 {
+		want += "."
+	}
+	return want
+}
+
+// TestAppendFloat32Small checks values below 2^-5, whose fractional bits do not fit in the 28-bit fixed point
+func TestAppendFloat32Small(t *testing.T) {
+	tests := []struct {
+		value     float32
+		precision int
+		want      string
+	}{
+		{5.0142694e-07, 6, "0.000001"},
+		{0.0026510505, 7, "0.0026511"},
+		{-0.0013067534, 7, "-0.0013068"},
+		{math.SmallestNonzeroFloat32, 46, "0.0000000000000000000000000000000000000000000014"},
+		{-math.SmallestNonzeroFloat32, 2, "-0.00"},
+		{0x1p-37, 12, "0.000000000007"},
+		{0x1.fffffep-38, 12, "0.000000000007"},
+	}
+	for _, test := range tests {
+		if got := string(AppendFloat32(nil, test.value, test.precision)); got != test.want {
+			t.Errorf("AppendFloat32(%v, %d) = %q, want %q", test.value, test.precision, got, test.want)
+		}
+		if want := float32Want(test.value, test.precision); test.want != want {
+			t.Errorf("strconv disagrees for %v: %q", test.value, want)
+		}
+	}
+}
+
+// TestAppendFloat32 compares random float32 values of every magnitude with strconv
+func TestAppendFloat32(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200000; i++ {
+		value := math.Float32frombits(rng.Uint32())
+		if i%2 == 0 {
+			// Favour the magnitudes around the fixed point limits
+			value = float32(rng.Float64() * math.Pow(2, float64(rng.Intn(60)-50)))
+		}
+		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+			continue
+		}
+		precision := rng.Intn(12)
+		if got, want := string(AppendFloat32(nil, value, precision)), float32Want(value, precision); got != want {
+			t.Fatalf("AppendFloat32(%v, %d) = %q, want %q", value, precision, got, want)
+		}
+	}
+}
+
+// TestAppendFloat32Rounded checks that every rounding mode agrees with the exact decimal expansion
+func TestAppendFloat32Rounded(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 50000; i++ {
+		value := float32(rng.Float64() * math.Pow(2, float64(rng.Intn(80)-60)))
+		if i%2 == 0 {
+			value = -value
+		}
+		precision := rng.Intn(12)
+		for _, mode := range []RoundingMode{RoundHalfEven, RoundHalfUp, RoundTruncate} {
+			got := string(AppendFloat32Rounded(nil, value, precision, mode))
+			want := string(appendFloatRoundedExact(nil, uint64(math.Float32bits(value)), &float32Info, precision, mode))
+			if got != want {
+				t.Fatalf("AppendFloat32Rounded(%v, %d, %d) = %q, want %q", value, precision, mode, got, want)
+			}
+		}
+	}
+}
+
+// TestFloat32DecimalSize checks the size computed from the bits of values beyond the uint32 range against the output
+// of AppendFloat32
+func TestFloat32DecimalSize(t *testing.T) {
+	for exp := 32; exp < 64; exp++ {
+		for _, value := range []float32{
+			float32(math.Ldexp(1, exp)),
+			math.Nextafter32(float32(math.Ldexp(1, exp)), 0),
+			math.Nextafter32(float32(math.Ldexp(1, exp)), float32(math.Inf(1))),
+			-float32(math.Ldexp(1.2345, exp)),
+		} {
+			if got, want := float32DecimalSize(value, 2), len(AppendFloat32(nil, value, 2)); got != want {
+				t.Fatalf("float32DecimalSize(%v, 2) = %d, want %d", value, got, want)
+			}
+		}
+	}
+	for _, value := range []float32{1 << 64, -1 << 64, math.MaxFloat32} {
+		if got := float32DecimalSize(value, 2); got != -1 {
+			t.Errorf("float32DecimalSize(%v, 2) = %d, want -1", value, got)
+		}
+	}
+}
+
+// TestFormatterFloat32Limits checks the integer digit and precision limits of Float32ToDecimalRounded at the size of
+// the buffer and one byte past it
+func TestFormatterFloat32Limits(t *testing.T) {
+	tests := []struct {
+		value     float32
+		precision int
+		want      string
+		err       tinygoerrors.ErrorCode
+	}{
+		{1 << 40, 0, "1099511627776.", tinygoerrors.ErrorCodeNil},
+		{1e19, 0, "9999999980506447872.", tinygoerrors.ErrorCodeNil},
+		{-1e19, 0, "", ErrorCodeBuffersTooManyIntegerDigits},
+		{-1e18, 0, "-999999984306749440.", tinygoerrors.ErrorCodeNil},
+		{1e20, 0, "", ErrorCodeBuffersTooManyIntegerDigits},
+		{math.MaxFloat32, 0, "", ErrorCodeBuffersTooManyIntegerDigits},
+		{1.5, Float64ToDecimalBufferSize - 2, "1.500000000000000000", tinygoerrors.ErrorCodeNil},
+		{1.5, Float64ToDecimalBufferSize - 1, "", ErrorCodeBuffersTooMuchPrecisionDigits},
+		{1e18, 1, "999999984306749440.0", tinygoerrors.ErrorCodeNil},
+		{1e19, 1, "", ErrorCodeBuffersTooMuchPrecisionDigits},
+	}
+	var f Formatter
+	for _, test := range tests {
+		got, err := f.Float32ToDecimalRounded(test.value, test.precision, RoundHalfEven)
+		if string(got) != test.want || err != test.err {
+			t.Errorf(
+				"Float32ToDecimalRounded(%v, %d) = %q, %d, want %q, %d",
+				test.value, test.precision, got, err, test.want, test.err,
+			)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !tinygo_buffers_nofpu
+
+package tinygo_buffers
+
+// float64FixedPointDefault reports whether the float64 decimal formatters use the integer arithmetic path by default
+const float64FixedPointDefault = false
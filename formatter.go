@@ -0,0 +1,180 @@
+package tinygo_buffers
+
+import (
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+type (
+	// Formatter converts numbers to their text representation using its own scratch buffers.
+	//
+	// Each Formatter owns its storage, so two Formatters never share state and can be used at the same time from
+	// different goroutines or from a goroutine and an interrupt handler. A single Formatter must not be used
+	// concurrently: the byte slices returned by its methods alias its buffers and are only valid until the next call
+	// to a method that reuses the same buffer. The zero value is ready to use.
+	//
+	// The package-level conversion functions are wrappers over a shared default Formatter and therefore have the
+	// same restriction. Code that runs concurrently should own a Formatter or use the Append* functions, which only
+	// write to the caller's slice.
+	Formatter struct {
+		uintToHexBuffer      [UintToHexPrefixedBufferSize]byte
+		uintToRadixBuffer    [UintToRadixBufferSize]byte
+		uintToDecimalBuffer  [UintToDecimalBufferSize]byte
+		intToDecimalBuffer   [IntToDecimalBufferSize]byte
+		floatToDecimalBuffer [Float64ToDecimalBufferSize + 1]byte
+	}
+)
+
+// defaultFormatter is the Formatter used by the package-level conversion functions
+var defaultFormatter Formatter
+
+// Uint8ToHex converts an uint8 value to its hexadecimal representation
+//
+// Parameters:
+//
+//	value: The uint8 value to convert.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint8 value.
+func (f *Formatter) Uint8ToHex(value uint8) []byte {
+	return AppendHex8(f.uintToHexBuffer[:0], value)
+}
+
+// Uint16ToHex converts an uint16 value to its hexadecimal representation
+//
+// Parameters:
+//
+//	value: The uint16 value to convert.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint16 value.
+func (f *Formatter) Uint16ToHex(value uint16) []byte {
+	return AppendHex16(f.uintToHexBuffer[:0], value)
+}
+
+// Uint32ToHex converts an uint32 value to its hexadecimal representation
+//
+// Parameters:
+//
+//	value: The uint32 value to convert.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint32 value.
+func (f *Formatter) Uint32ToHex(value uint32) []byte {
+	return AppendHex32(f.uintToHexBuffer[:0], value)
+}
+
+// Uint64ToHex converts an uint64 value to its hexadecimal representation
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint64 value.
+func (f *Formatter) Uint64ToHex(value uint64) []byte {
+	return AppendHex64(f.uintToHexBuffer[:0], value)
+}
+
+// UintToDecimal converts an uint64 value to its decimal representation
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the uint64 value.
+func (f *Formatter) UintToDecimal(value uint64) []byte {
+	return AppendUintDecimal(f.uintToDecimalBuffer[:0], value)
+}
+
+// IntToDecimal converts an int64 value to its decimal representation
+//
+// Parameters:
+//
+//	value: The int64 value to convert.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the int64 value, including a minus sign if negative.
+func (f *Formatter) IntToDecimal(value int64) []byte {
+	return AppendIntDecimal(f.intToDecimalBuffer[:0], value)
+}
+
+// UintToDecimalFixed converts an uint64 value to its decimal representation with fixed width
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	width: The fixed width for the decimal representation, limited to UintToDecimalBufferSize.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the uint64 value with leading zeros if necessary.
+func (f *Formatter) UintToDecimalFixed(value uint64, width int) []byte {
+	if width > len(f.uintToDecimalBuffer) {
+		width = len(f.uintToDecimalBuffer)
+	}
+	return AppendUintDecimalFixed(f.uintToDecimalBuffer[:0], value, width)
+}
+
+// Float64ToDecimal converts a float64 value to its decimal representation with specified precision, rounding half to
+// even
+//
+// Parameters:
+//
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the float64 value and an error code indicating success or
+// failure. If the integer part does not fit in the buffer, ErrorCodeBuffersTooManyIntegerDigits is returned.
+func (f *Formatter) Float64ToDecimal(value float64, precision int) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	return f.Float64ToDecimalRounded(value, precision, RoundHalfEven)
+}
+
+// Float64ToDecimalRounded converts a float64 value to its decimal representation with specified precision and rounding
+// mode
+//
+// Parameters:
+//
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the float64 value and an error code indicating success or
+// failure. If the integer part does not fit in the buffer, ErrorCodeBuffersTooManyIntegerDigits is returned.
+func (f *Formatter) Float64ToDecimalRounded(value float64, precision int, mode RoundingMode) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	if precision < 0 {
+		precision = 0
+	}
+
+	// Check the buffer limit before converting
+	size := float64DecimalSize(value, precision)
+	if size < 0 || size-precision > Float64ToDecimalBufferSize {
+		return nil, ErrorCodeBuffersTooManyIntegerDigits
+	}
+	if size > Float64ToDecimalBufferSize {
+		return nil, ErrorCodeBuffersTooMuchPrecisionDigits
+	}
+
+	// The buffer has room for the extra integer digit a rounding carry may add
+	buffer := AppendFloat64Rounded(f.floatToDecimalBuffer[:0], value, precision, mode)
+	if len(buffer) > Float64ToDecimalBufferSize {
+		return nil, ErrorCodeBuffersTooManyIntegerDigits
+	}
+	return buffer, tinygoerrors.ErrorCodeNil
+}
@@ -0,0 +1,171 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// TestFormatterConcurrent formats different values from several goroutines, each one owning a Formatter, and checks
+// that no goroutine sees the output of another. Run it with -race to check that Formatters share no state.
+func TestFormatterConcurrent(t *testing.T) {
+	const (
+		goroutines = 8
+		iterations = 2000
+	)
+
+	var wg sync.WaitGroup
+	errs := make(chan string, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			var f Formatter
+			for i := 0; i < iterations; i++ {
+				value := uint64(g*iterations + i)
+				if got, want := string(f.UintToDecimal(value)), strconv.FormatUint(value, 10); got != want {
+					errs <- "UintToDecimal: got " + got + ", want " + want
+					return
+				}
+				if got, want := string(f.IntToDecimal(-int64(value))), strconv.FormatInt(-int64(value), 10); got != want {
+					errs <- "IntToDecimal: got " + got + ", want " + want
+					return
+				}
+				hex := strings.ToUpper(strconv.FormatUint(value, 16))
+				if got, want := string(f.Uint32ToHexFormatted(uint32(value), FormatTrimLeadingZeros)), hex; got != want {
+					errs <- "Uint32ToHexFormatted: got " + got + ", want " + want
+					return
+				}
+				got, err := f.Float64ToDecimal(float64(value)/4, 2)
+				if want := strconv.FormatFloat(float64(value)/4, 'f', 2, 64); err != 0 || string(got) != want {
+					errs <- "Float64ToDecimal: got " + string(got) + ", want " + want
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+// TestAppendConcurrent checks that the Append* functions only write to the caller's slice, so they can be called
+// concurrently without a Formatter.
+func TestAppendConcurrent(t *testing.T) {
+	const goroutines = 8
+
+	var wg sync.WaitGroup
+	results := make([][]byte, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			var buffer [64]byte
+			for i := 0; i < 1000; i++ {
+				line := AppendUintDecimal(buffer[:0], uint64(g))
+				line = append(line, ':')
+				line = AppendHex16(line, uint16(g))
+				results[g] = append(results[g][:0], line...)
+			}
+		}(g)
+	}
+	wg.Wait()
+	for g, line := range results {
+		if want := strconv.Itoa(g) + ":000" + strconv.Itoa(g); string(line) != want {
+			t.Errorf("goroutine %d: got %q, want %q", g, line, want)
+		}
+	}
+}
+
+// TestFormatterBuffersAreIndependent checks that two Formatters do not alias each other's output
+func TestFormatterBuffersAreIndependent(t *testing.T) {
+	var a, b Formatter
+	first := a.UintToDecimal(12345)
+	b.UintToDecimal(67890)
+	if string(first) != "12345" {
+		t.Errorf("got %q after formatting with another Formatter, want %q", first, "12345")
+	}
+}
+
+// TestFormatterFloat64Limits checks the integer digit and precision limits of Float64ToDecimalRounded at the size of
+// the buffer and one byte past it
+func TestFormatterFloat64Limits(t *testing.T) {
+	tests := []struct {
+		value     float64
+		precision int
+		want      string
+		err       tinygoerrors.ErrorCode
+	}{
+		// The integer part with the dot fills the buffer, or is one byte too long
+		{1e18, 0, "1000000000000000000.", tinygoerrors.ErrorCodeNil},
+		{1e19, 0, "", ErrorCodeBuffersTooManyIntegerDigits},
+		{-1e17, 0, "-100000000000000000.", tinygoerrors.ErrorCodeNil},
+		{-1e18, 0, "", ErrorCodeBuffersTooManyIntegerDigits},
+		{1e20, 0, "", ErrorCodeBuffersTooManyIntegerDigits},
+		{-math.MaxFloat64, 2, "", ErrorCodeBuffersTooManyIntegerDigits},
+
+		// The fractional digits fill the buffer, or are one digit too many
+		{1.5, Float64ToDecimalBufferSize - 2, "1.500000000000000000", tinygoerrors.ErrorCodeNil},
+		{1.5, Float64ToDecimalBufferSize - 1, "", ErrorCodeBuffersTooMuchPrecisionDigits},
+		{-1.5, Float64ToDecimalBufferSize - 3, "-1.50000000000000000", tinygoerrors.ErrorCodeNil},
+		{-1.5, Float64ToDecimalBufferSize - 2, "", ErrorCodeBuffersTooMuchPrecisionDigits},
+		{1e18, 1, "", ErrorCodeBuffersTooMuchPrecisionDigits},
+
+		// A rounding carry adds an integer digit
+		{-999999999999999.5, 0, "-1000000000000000.", tinygoerrors.ErrorCodeNil},
+		{99.996, 2, "100.00", tinygoerrors.ErrorCodeNil},
+
+		// The special values ignore the precision
+		{math.NaN(), Float64ToDecimalBufferSize, "NaN", tinygoerrors.ErrorCodeNil},
+		{math.Inf(-1), Float64ToDecimalBufferSize, "-Inf", tinygoerrors.ErrorCodeNil},
+	}
+	var f Formatter
+	for _, test := range tests {
+		got, err := f.Float64ToDecimalRounded(test.value, test.precision, RoundHalfEven)
+		if string(got) != test.want || err != test.err {
+			t.Errorf(
+				"Float64ToDecimalRounded(%v, %d) = %q, %d, want %q, %d",
+				test.value, test.precision, got, err, test.want, test.err,
+			)
+		}
+	}
+}
+
+// TestDeprecatedBuffers checks that the package-level conversions still copy their output to the deprecated buffers,
+// at the position the buffers used before the Formatter
+func TestDeprecatedBuffers(t *testing.T) {
+	Uint64ToHex(0x0123456789ABCDEF)
+	Uint8ToHex(0xA5)
+	if got := string(UintToHexBuffer[:]); got != "A523456789ABCDEF" {
+		t.Errorf("UintToHexBuffer = %q after Uint8ToHex(0xA5)", got)
+	}
+
+	UintToDecimal(12345)
+	if got := string(UintToDecimalBuffer[UintToDecimalBufferSize-5:]); got != "12345" {
+		t.Errorf("UintToDecimalBuffer ends with %q after UintToDecimal(12345)", got)
+	}
+	UintToDecimalFixed(42, 4)
+	if got := string(UintToDecimalBuffer[:4]); got != "0042" {
+		t.Errorf("UintToDecimalBuffer starts with %q after UintToDecimalFixed(42, 4)", got)
+	}
+
+	IntToDecimal(math.MinInt64)
+	if got := string(IntToDecimalBuffer[:]); got != "-9223372036854775808" {
+		t.Errorf("IntToDecimalBuffer = %q after IntToDecimal(math.MinInt64)", got)
+	}
+
+	Float64ToDecimal(-1.25, 2)
+	if got := string(Float64ToDecimalBuffer[:5]); got != "-1.25" {
+		t.Errorf("Float64ToDecimalBuffer starts with %q after Float64ToDecimal(-1.25, 2)", got)
+	}
+	Float64ToDecimalRounded(2.5, 0, RoundHalfUp)
+	if got := string(Float64ToDecimalBuffer[:2]); got != "3." {
+		t.Errorf("Float64ToDecimalBuffer starts with %q after Float64ToDecimalRounded(2.5, 0, RoundHalfUp)", got)
+	}
+}
@@ -0,0 +1,183 @@
+package tinygo_buffers
+
+import (
+	"encoding/binary"
+	"unsafe"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+type (
+	// Number is the set of fixed-size numeric types that can be encoded to and decoded from bytes. The set contains
+	// the exact types only, since their size must be known to select the encoding
+	Number interface {
+		uint8 | int8 | uint16 | int16 | uint32 | int32 | uint64 | int64 | float32 | float64
+	}
+)
+
+// SizeOf returns the encoded size of a number type
+//
+// Returns:
+//
+// The number of bytes used to encode a value of type T.
+func SizeOf[T Number]() int {
+	var value T
+	return int(unsafe.Sizeof(value))
+}
+
+// PutBE encodes a number in big-endian order, storing the result in the provided buffer. Floats are encoded with their
+// IEEE-754 bits
+//
+// Parameters:
+//
+//	buffer: A byte slice with at least SizeOf[T]() bytes to store the resulting bytes.
+//	value: The value to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutBE[T Number](buffer []byte, value T) tinygoerrors.ErrorCode {
+	bits := numberBits(value)
+	switch size := unsafe.Sizeof(value); {
+	case len(buffer) < int(size):
+		return ErrorCodeBuffersInvalidBufferSize
+	case size == 1:
+		buffer[0] = uint8(bits)
+	case size == 2:
+		binary.BigEndian.PutUint16(buffer, uint16(bits))
+	case size == 4:
+		binary.BigEndian.PutUint32(buffer, uint32(bits))
+	default:
+		binary.BigEndian.PutUint64(buffer, bits)
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// PutLE encodes a number in little-endian order, storing the result in the provided buffer. Floats are encoded with
+// their IEEE-754 bits
+//
+// Parameters:
+//
+//	buffer: A byte slice with at least SizeOf[T]() bytes to store the resulting bytes.
+//	value: The value to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutLE[T Number](buffer []byte, value T) tinygoerrors.ErrorCode {
+	bits := numberBits(value)
+	switch size := unsafe.Sizeof(value); {
+	case len(buffer) < int(size):
+		return ErrorCodeBuffersInvalidBufferSize
+	case size == 1:
+		buffer[0] = uint8(bits)
+	case size == 2:
+		binary.LittleEndian.PutUint16(buffer, uint16(bits))
+	case size == 4:
+		binary.LittleEndian.PutUint32(buffer, uint32(bits))
+	default:
+		binary.LittleEndian.PutUint64(buffer, bits)
+	}
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetBE decodes a number in big-endian order from a byte slice
+//
+// Parameters:
+//
+//	data: A byte slice containing at least SizeOf[T]() bytes.
+//
+// Returns:
+//
+// The value represented by the first SizeOf[T]() bytes of the input slice, or an error code if the input is invalid.
+func GetBE[T Number](data []byte) (T, tinygoerrors.ErrorCode) {
+	var value T
+	var bits uint64
+	switch size := unsafe.Sizeof(value); {
+	case len(data) < int(size):
+		return value, ErrorCodeBuffersInvalidBufferSize
+	case size == 1:
+		bits = uint64(data[0])
+	case size == 2:
+		bits = uint64(binary.BigEndian.Uint16(data))
+	case size == 4:
+		bits = uint64(binary.BigEndian.Uint32(data))
+	default:
+		bits = binary.BigEndian.Uint64(data)
+	}
+	return numberFromBits[T](bits), tinygoerrors.ErrorCodeNil
+}
+
+// GetLE decodes a number in little-endian order from a byte slice
+//
+// Parameters:
+//
+//	data: A byte slice containing at least SizeOf[T]() bytes.
+//
+// Returns:
+//
+// The value represented by the first SizeOf[T]() bytes of the input slice, or an error code if the input is invalid.
+func GetLE[T Number](data []byte) (T, tinygoerrors.ErrorCode) {
+	var value T
+	var bits uint64
+	switch size := unsafe.Sizeof(value); {
+	case len(data) < int(size):
+		return value, ErrorCodeBuffersInvalidBufferSize
+	case size == 1:
+		bits = uint64(data[0])
+	case size == 2:
+		bits = uint64(binary.LittleEndian.Uint16(data))
+	case size == 4:
+		bits = uint64(binary.LittleEndian.Uint32(data))
+	default:
+		bits = binary.LittleEndian.Uint64(data)
+	}
+	return numberFromBits[T](bits), tinygoerrors.ErrorCodeNil
+}
+
+// numberBits returns the bits of a number, reinterpreted as an unsigned integer of the same size and zero-extended
+//
+// Parameters:
+//
+//	value: The value to convert.
+//
+// Returns:
+//
+// The bits of the value.
+func numberBits[T Number](value T) uint64 {
+	pointer := unsafe.Pointer(&value)
+	switch unsafe.Sizeof(value) {
+	case 1:
+		return uint64(*(*uint8)(pointer))
+	case 2:
+		return uint64(*(*uint16)(pointer))
+	case 4:
+		return uint64(*(*uint32)(pointer))
+	}
+	return *(*uint64)(pointer)
+}
+
+// numberFromBits returns the number whose bits are the low bits of an unsigned integer
+//
+// Parameters:
+//
+//	bits: The bits of the value, only the low SizeOf[T]() bytes are used.
+//
+// Returns:
+//
+// The value with the given bits.
+func numberFromBits[T Number](bits uint64) T {
+	var value T
+	pointer := unsafe.Pointer(&value)
+	switch unsafe.Sizeof(value) {
+	case 1:
+		*(*uint8)(pointer) = uint8(bits)
+	case 2:
+		*(*uint16)(pointer) = uint16(bits)
+	case 4:
+		*(*uint32)(pointer) = uint32(bits)
+	default:
+		*(*uint64)(pointer) = bits
+	}
+	return value
+}
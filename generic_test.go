@@ -0,0 +1,118 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// checkGeneric checks PutBE, PutLE, GetBE and GetLE against the known big-endian encoding of a value, whose reverse is
+// the little-endian encoding
+//
+// Parameters:
+//
+//	t: The test.
+//	value: The value to encode.
+//	want: The big-endian encoding of the value.
+func checkGeneric[T Number](t *testing.T, value T, want []byte) {
+	t.Helper()
+	if size := SizeOf[T](); size != len(want) {
+		t.Fatalf("SizeOf[%T]() = %d, want %d", value, size, len(want))
+	}
+	wantLE := make([]byte, len(want))
+	for i, c := range want {
+		wantLE[len(want)-1-i] = c
+	}
+
+	// A guard byte after the value must not be written nor read
+	for _, test := range []struct {
+		name string
+		put  func([]byte, T) tinygoerrors.ErrorCode
+		get  func([]byte) (T, tinygoerrors.ErrorCode)
+		want []byte
+	}{
+		{"BE", PutBE[T], GetBE[T], want},
+		{"LE", PutLE[T], GetLE[T], wantLE},
+	} {
+		buffer := make([]byte, len(test.want)+1)
+		buffer[len(test.want)] = 0xA5
+		if err := test.put(buffer, value); err != tinygoerrors.ErrorCodeNil ||
+			!bytes.Equal(buffer, append(test.want[:len(test.want):len(test.want)], 0xA5)) {
+			t.Errorf("Put%s(%T(%v)) = % X, %d, want % X A5", test.name, value, value, buffer, err, test.want)
+		}
+		got, err := test.get(buffer)
+		if err != tinygoerrors.ErrorCodeNil || numberBits(got) != numberBits(value) {
+			t.Errorf("Get%s[%T](% X) = %v, %d, want %v", test.name, value, buffer, got, err, value)
+		}
+
+		// Short buffers are rejected, and leave the buffer and the zero value
+		short := make([]byte, len(test.want)-1)
+		err = test.put(short, value)
+		if err != ErrorCodeBuffersInvalidBufferSize || !bytes.Equal(short, make([]byte, len(short))) {
+			t.Errorf("Put%s[%T] with a short buffer = % X, %d", test.name, value, short, err)
+		}
+		if got, err := test.get(test.want[:len(test.want)-1]); err != ErrorCodeBuffersInvalidBufferSize || got != 0 {
+			t.Errorf("Get%s[%T] with a short buffer = %v, %d", test.name, value, got, err)
+		}
+	}
+}
+
+// TestGenericKnownAnswers checks every Number type against known encodings, including the single-byte paths and the
+// sign of the signed types
+func TestGenericKnownAnswers(t *testing.T) {
+	checkGeneric(t, uint8(0xAB), []byte{0xAB})
+	checkGeneric(t, uint8(0xFF), []byte{0xFF})
+	checkGeneric(t, int8(-1), []byte{0xFF})
+	checkGeneric(t, int8(math.MinInt8), []byte{0x80})
+	checkGeneric(t, int8(math.MaxInt8), []byte{0x7F})
+	checkGeneric(t, uint16(0x1122), []byte{0x11, 0x22})
+	checkGeneric(t, int16(math.MinInt16), []byte{0x80, 0x00})
+	checkGeneric(t, int16(-2), []byte{0xFF, 0xFE})
+	checkGeneric(t, uint32(0x11223344), []byte{0x11, 0x22, 0x33, 0x44})
+	checkGeneric(t, int32(math.MinInt32), []byte{0x80, 0x00, 0x00, 0x00})
+	checkGeneric(t, int32(-2), []byte{0xFF, 0xFF, 0xFF, 0xFE})
+	checkGeneric(t, uint64(0x1122334455667788), []byte{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88})
+	checkGeneric(t, int64(math.MinInt64), []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	checkGeneric(t, int64(-2), []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE})
+	checkGeneric(t, float32(1.5), []byte{0x3F, 0xC0, 0x00, 0x00})
+	checkGeneric(t, float32(math.Copysign(0, -1)), []byte{0x80, 0x00, 0x00, 0x00})
+	checkGeneric(t, float32(math.Inf(-1)), []byte{0xFF, 0x80, 0x00, 0x00})
+	checkGeneric(t, 1.5, []byte{0x3F, 0xF8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	checkGeneric(t, math.Copysign(0, -1), []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	checkGeneric(t, math.Inf(1), []byte{0x7F, 0xF0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+}
+
+// TestGenericNaNPayload checks that the payload and sign of NaNs, quiet and signaling, survive the encoding and the
+// decoding in both orders
+func TestGenericNaNPayload(t *testing.T) {
+	for _, bits := range []uint32{0x7FC00001, 0xFFC00000, 0x7FA5A5A5, 0x7F800001} {
+		var buffer [4]byte
+		PutBE(buffer[:], math.Float32frombits(bits))
+		if buffer[0] != uint8(bits>>24) || buffer[3] != uint8(bits) {
+			t.Errorf("PutBE(NaN %#08x) = % X", bits, buffer)
+		}
+		if value, _ := GetBE[float32](buffer[:]); math.Float32bits(value) != bits {
+			t.Errorf("GetBE[float32](% X) = %#08x, want %#08x", buffer, math.Float32bits(value), bits)
+		}
+		PutLE(buffer[:], math.Float32frombits(bits))
+		if value, _ := GetLE[float32](buffer[:]); math.Float32bits(value) != bits || buffer[0] != uint8(bits) {
+			t.Errorf("GetLE[float32](% X) = %#08x, want %#08x", buffer, math.Float32bits(value), bits)
+		}
+	}
+	for _, bits := range []uint64{0x7FF8000000000001, 0xFFF8000000000000, 0x7FF5A5A5A5A5A5A5, 0x7FF0000000000001} {
+		var buffer [8]byte
+		PutBE(buffer[:], math.Float64frombits(bits))
+		if buffer[0] != uint8(bits>>56) || buffer[7] != uint8(bits) {
+			t.Errorf("PutBE(NaN %#016x) = % X", bits, buffer)
+		}
+		if value, _ := GetBE[float64](buffer[:]); math.Float64bits(value) != bits {
+			t.Errorf("GetBE[float64](% X) = %#016x, want %#016x", buffer, math.Float64bits(value), bits)
+		}
+		PutLE(buffer[:], math.Float64frombits(bits))
+		if value, _ := GetLE[float64](buffer[:]); math.Float64bits(value) != bits || buffer[0] != uint8(bits) {
+			t.Errorf("GetLE[float64](% X) = %#016x, want %#016x", buffer, math.Float64bits(value), bits)
+		}
+	}
+}
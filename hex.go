@@ -0,0 +1,373 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// AppendHex8Formatted appends the hexadecimal representation of an uint8 value with the given format flags to the
+// given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHex8Formatted(dst []byte, value uint8, flags FormatFlags) []byte {
+	return appendHexFormatted(dst, uint64(value), 8, flags)
+}
+
+// AppendHex16Formatted appends the hexadecimal representation of an uint16 value with the given format flags to the
+// given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHex16Formatted(dst []byte, value uint16, flags FormatFlags) []byte {
+	return appendHexFormatted(dst, uint64(value), 16, flags)
+}
+
+// AppendHex32Formatted appends the hexadecimal representation of an uint32 value with the given format flags to the
+// given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHex32Formatted(dst []byte, value uint32, flags FormatFlags) []byte {
+	return appendHexFormatted(dst, uint64(value), 32, flags)
+}
+
+// AppendHex64Formatted appends the hexadecimal representation of an uint64 value with the given format flags to the
+// given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHex64Formatted(dst []byte, value uint64, flags FormatFlags) []byte {
+	return appendHexFormatted(dst, uint64(value), 64, flags)
+}
+
+// appendHexFormatted appends the hexadecimal representation of a value of the given bit size with the given format
+// flags
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The value to convert.
+//	size: The size of the value in bits (8, 16, 32, or 64).
+//	flags: The format flags to apply.
+//
+// Returns:
+//
+// The extended byte slice.
+func appendHexFormatted(dst []byte, value uint64, size int, flags FormatFlags) []byte {
+	if flags&FormatPrefix != 0 {
+		dst = append(dst, HexPrefix...)
+	}
+	digits := hexDigitsFor(flags)
+
+	// Convert the high half of 64-bit values first, so the digits are produced in 32-bit arithmetic
+	if size == 64 {
+		if high := uint32(value >> 32); high != 0 || flags&FormatTrimLeadingZeros == 0 {
+			dst = appendHexDigits(dst, high, 32, flags, digits)
+			flags &^= FormatTrimLeadingZeros
+		}
+		size = 32
+	}
+	return appendHexDigits(dst, uint32(value), size, flags, digits)
+}
+
+// appendHexDigits appends the hexadecimal digits of a value of the given bit size, one byte at a time
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The value to convert.
+//	size: The size of the value in bits (8, 16, or 32).
+//	flags: The format flags to apply, only FormatTrimLeadingZeros is used.
+//	digits: The table of hex digits, ASCIIHexDigits or ASCIILowerHexDigits.
+//
+// Returns:
+//
+// The extended byte slice.
+func appendHexDigits(dst []byte, value uint32, size int, flags FormatFlags, digits []byte) []byte {
+	if flags&FormatTrimLeadingZeros != 0 {
+		for size > 4 && value>>(size-4) == 0 {
+			size -= 4
+		}
+
+		// Write the odd leading nibble on its own, so the remaining digits are whole bytes
+		if size%8 != 0 {
+			size -= 4
+			dst = append(dst, digits[value>>size&0x0F])
+		}
+	}
+	for shift := size - 8; shift >= 0; shift -= 8 {
+		dst = appendHexByte(dst, byte(value>>shift), digits)
+	}
+	return dst
+}
+
+// appendHexByte appends the two hexadecimal digits of a byte, looking up each nibble in the given table
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The byte to convert.
+//	digits: The table of hex digits, ASCIIHexDigits or ASCIILowerHexDigits.
+//
+// Returns:
+//
+// The extended byte slice.
+func appendHexByte(dst []byte, value byte, digits []byte) []byte {
+	return append(dst, digits[value>>4], digits[value&0x0F])
+}
+
+// hexDigitsFor returns the table of hex digits selected by the format flags
+//
+// Parameters:
+//
+//	flags: The format flags.
+//
+// Returns:
+//
+// ASCIILowerHexDigits if FormatLowercase is set, or ASCIIHexDigits otherwise.
+func hexDigitsFor(flags FormatFlags) []byte {
+	if flags&FormatLowercase != 0 {
+		return ASCIILowerHexDigits
+	}
+	return ASCIIHexDigits
+}
+
+// HexEncodedLen returns the number of bytes needed to hex encode a number of bytes
+//
+// Parameters:
+//
+//	n: The number of bytes to encode.
+//	separator: The separator written between the encoded bytes, or nil for none.
+//
+// Returns:
+//
+// The length of the encoded text.
+func HexEncodedLen(n int, separator []byte) int {
+	if n <= 0 {
+		return 0
+	}
+	return n*2 + (n-1)*len(separator)
+}
+
+// AppendHexEncoded appends the hexadecimal encoding of a byte slice to the given byte slice, like "DE:AD:BE:EF"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	src: The bytes to encode.
+//	separator: The separator written between the encoded bytes, like TwoPointsBuffer or WhitespaceBuffer, or nil for
+//	none.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHexEncoded(dst, src, separator []byte) []byte {
+	for i, value := range src {
+		if i > 0 {
+			dst = append(dst, separator...)
+		}
+		dst = appendHexByte(dst, value, ASCIIHexDigits)
+	}
+	return dst
+}
+
+// HexEncode writes the hexadecimal encoding of a byte slice to the given buffer
+//
+// Parameters:
+//
+//	dst: The buffer to write to, with at least HexEncodedLen(len(src), separator) bytes.
+//	src: The bytes to encode.
+//	separator: The separator written between the encoded bytes, like TwoPointsBuffer or WhitespaceBuffer, or nil for
+//	none.
+//
+// Returns:
+//
+// The number of bytes written to dst, or an error if dst is too small.
+func HexEncode(dst, src, separator []byte) (int, tinygoerrors.ErrorCode) {
+	if len(dst) < HexEncodedLen(len(src), separator) {
+		return 0, ErrorCodeBuffersInvalidBufferSize
+	}
+	return len(AppendHexEncoded(dst[:0], src, separator)), tinygoerrors.ErrorCodeNil
+}
+
+// HexDecode decodes hexadecimal text into the given buffer, accepting uppercase and lowercase digits
+//
+// Parameters:
+//
+//	dst: The buffer to write the decoded bytes to.
+//	src: The text to decode, with two digits per byte.
+//	separator: The separator expected between the encoded bytes, like TwoPointsBuffer or WhitespaceBuffer, or nil for
+//	none.
+//
+// Returns:
+//
+// The number of bytes written to dst, or an error if src contains an invalid digit, a missing separator or an
+// incomplete byte, or if dst is too small. Empty text decodes to zero bytes.
+func HexDecode(dst, src, separator []byte) (int, tinygoerrors.ErrorCode) {
+	n := 0
+	for i := 0; i < len(src); i += 2 {
+		// Expect the separator between bytes
+		if n > 0 && len(separator) > 0 {
+			if !bytes.HasPrefix(src[i:], separator) {
+				return n, ErrorCodeBuffersInvalidSeparator
+			}
+			i += len(separator)
+		}
+		if i+1 >= len(src) {
+			return n, ErrorCodeBuffersIncompleteHexByte
+		}
+
+		high, low := HexDigitValue(src[i]), HexDigitValue(src[i+1])
+		if high < 0 || low < 0 {
+			return n, ErrorCodeBuffersInvalidDigit
+		}
+		if n >= len(dst) {
+			return n, ErrorCodeBuffersInvalidBufferSize
+		}
+		dst[n] = byte(high<<4 | low)
+		n++
+	}
+	return n, tinygoerrors.ErrorCodeNil
+}
+
+// Uint8ToHexFormatted converts an uint8 value to its hexadecimal representation with the given format flags
+//
+// Parameters:
+//
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint8 value.
+func (f *Formatter) Uint8ToHexFormatted(value uint8, flags FormatFlags) []byte {
+	return AppendHex8Formatted(f.uintToHexBuffer[:0], value, flags)
+}
+
+// Uint16ToHexFormatted converts an uint16 value to its hexadecimal representation with the given format flags
+//
+// Parameters:
+//
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint16 value.
+func (f *Formatter) Uint16ToHexFormatted(value uint16, flags FormatFlags) []byte {
+	return AppendHex16Formatted(f.uintToHexBuffer[:0], value, flags)
+}
+
+// Uint32ToHexFormatted converts an uint32 value to its hexadecimal representation with the given format flags
+//
+// Parameters:
+//
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint32 value.
+func (f *Formatter) Uint32ToHexFormatted(value uint32, flags FormatFlags) []byte {
+	return AppendHex32Formatted(f.uintToHexBuffer[:0], value, flags)
+}
+
+// Uint64ToHexFormatted converts an uint64 value to its hexadecimal representation with the given format flags
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint64 value.
+func (f *Formatter) Uint64ToHexFormatted(value uint64, flags FormatFlags) []byte {
+	return AppendHex64Formatted(f.uintToHexBuffer[:0], value, flags)
+}
+
+// Uint8ToHexFormatted converts an uint8 value to its hexadecimal representation with the given format flags
+//
+// Parameters:
+//
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint8 value.
+func Uint8ToHexFormatted(value uint8, flags FormatFlags) []byte {
+	return defaultFormatter.Uint8ToHexFormatted(value, flags)
+}
+
+// Uint16ToHexFormatted converts an uint16 value to its hexadecimal representation with the given format flags
+//
+// Parameters:
+//
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint16 value.
+func Uint16ToHexFormatted(value uint16, flags FormatFlags) []byte {
+	return defaultFormatter.Uint16ToHexFormatted(value, flags)
+}
+
+// Uint32ToHexFormatted converts an uint32 value to its hexadecimal representation with the given format flags
+//
+// Parameters:
+//
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint32 value.
+func Uint32ToHexFormatted(value uint32, flags FormatFlags) []byte {
+	return defaultFormatter.Uint32ToHexFormatted(value, flags)
+}
+
+// Uint64ToHexFormatted converts an uint64 value to its hexadecimal representation with the given format flags
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatTrimLeadingZeros to apply.
+//
+// Returns:
+//
+// A byte slice representing the hexadecimal representation of the uint64 value.
+func Uint64ToHexFormatted(value uint64, flags FormatFlags) []byte {
+	return defaultFormatter.Uint64ToHexFormatted(value, flags)
+}
@@ -0,0 +1,270 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// appendHexLoop is the conversion loop of AppendHex8 to AppendHex64 before the nibble table, one UintToHexIndex call
+// per digit, kept as the reference of the fuzz test and the benchmark baseline
+func appendHexLoop(dst []byte, value uint64, size int) []byte {
+	for c := 0; c < size/4; c++ {
+		dst = append(dst, ASCIIHexDigits[UintToHexIndex(value, size, c)])
+	}
+	return dst
+}
+
+// hexWant returns the upper case, zero-padded hexadecimal representation of value in the given bit size
+func hexWant(value uint64, size int) string {
+	digits := strconv.FormatUint(value, 16)
+	return strings.Repeat("0", size/4-len(digits)) + strings.ToUpper(digits)
+}
+
+// FuzzHex compares the legacy UintNToHex functions and the Append functions with the previous loop and strconv
+func FuzzHex(f *testing.F) {
+	seeds := []uint64{0, 1, 0x0F, 0xA5, 0xFFFF, 0x12345678, 0xDEADBEEF, 1 << 32, 0xFEDCBA9876543210, 1<<64 - 1}
+	for _, value := range seeds {
+		f.Add(value)
+	}
+	f.Fuzz(func(t *testing.T, value uint64) {
+		for _, size := range []int{8, 16, 32, 64} {
+			truncated := value
+			if size < 64 {
+				truncated &= 1<<size - 1
+			}
+
+			// The legacy functions share the buffer of the default Formatter, so their output is copied before the
+			// next call
+			var legacy, appended string
+			switch size {
+			case 8:
+				legacy, appended = string(Uint8ToHex(uint8(value))), string(AppendHex8(nil, uint8(value)))
+			case 16:
+				legacy, appended = string(Uint16ToHex(uint16(value))), string(AppendHex16(nil, uint16(value)))
+			case 32:
+				legacy, appended = string(Uint32ToHex(uint32(value))), string(AppendHex32(nil, uint32(value)))
+			default:
+				legacy, appended = string(Uint64ToHex(value)), string(AppendHex64(nil, value))
+			}
+
+			want := hexWant(truncated, size)
+			if loop := string(appendHexLoop(nil, truncated, size)); loop != want {
+				t.Fatalf("previous loop for %#x, size %d = %q, want %q", truncated, size, loop, want)
+			}
+			if legacy != want {
+				t.Errorf("Uint%dToHex(%#x) = %q, want %q", size, truncated, legacy, want)
+			}
+			if appended != want {
+				t.Errorf("AppendHex%d(%#x) = %q, want %q", size, truncated, appended, want)
+			}
+		}
+
+		// The formatted variant without padding matches strconv directly
+		got := string(AppendHex64Formatted(nil, value, FormatLowercase|FormatTrimLeadingZeros))
+		if want := strconv.FormatUint(value, 16); got != want {
+			t.Errorf("AppendHex64Formatted(%#x) = %q, want %q", value, got, want)
+		}
+	})
+}
+
+// BenchmarkHex compares the nibble table conversion with the previous UintToHexIndex loop
+func BenchmarkHex(b *testing.B) {
+	const value = 0xFEDCBA9876543210
+	var buffer [UintToHexBufferSize]byte
+	for _, size := range []int{8, 16, 32, 64} {
+		name := strconv.Itoa(size)
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				appendHexFormatted(buffer[:0], uint64(value)>>(64-size), size, 0)
+			}
+		})
+		b.Run(name+"Loop", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				appendHexLoop(buffer[:0], uint64(value)>>(64-size), size)
+			}
+		})
+	}
+}
+
+// TestFormatFlags checks combinations of the format flags, including zero values with trimmed leading zeros,
+// grouping of odd digit counts, which the hex formatters ignore, and prefixes with lowercase digits
+func TestFormatFlags(t *testing.T) {
+	radix := func(value uint64, base int, flags FormatFlags) []byte {
+		digits, _ := AppendUintRadix(nil, value, base, flags)
+		return digits
+	}
+	for _, test := range []struct {
+		name string
+		got  []byte
+		want string
+	}{
+		{"AppendHex8Formatted(0, trim)", AppendHex8Formatted(nil, 0, FormatTrimLeadingZeros), "0"},
+		{"AppendHex16Formatted(0, trim|prefix)", AppendHex16Formatted(
+			nil, 0, FormatTrimLeadingZeros|FormatPrefix), "0x0"},
+		{"AppendHex32Formatted(0, trim)", AppendHex32Formatted(nil, 0, FormatTrimLeadingZeros), "0"},
+		{"AppendHex64Formatted(0, trim)", AppendHex64Formatted(nil, 0, FormatTrimLeadingZeros), "0"},
+		{"AppendHex8Formatted(0xAB, prefix|lower|trim)", AppendHex8Formatted(
+			nil, 0xAB, FormatPrefix|FormatLowercase|FormatTrimLeadingZeros), "0xab"},
+		{"AppendHex16Formatted(0xF0, prefix|lower|trim)", AppendHex16Formatted(
+			nil, 0xF0, FormatPrefix|FormatLowercase|FormatTrimLeadingZeros), "0xf0"},
+		{"AppendHex32Formatted(0xABC, trim)", AppendHex32Formatted(nil, 0xABC, FormatTrimLeadingZeros), "ABC"},
+		{"AppendHex64Formatted(0x100000000, trim)", AppendHex64Formatted(
+			nil, 0x100000000, FormatTrimLeadingZeros), "100000000"},
+		{"AppendHex64Formatted(0xABCDEF, prefix|lower)", AppendHex64Formatted(
+			nil, 0xABCDEF, FormatPrefix|FormatLowercase), "0x0000000000abcdef"},
+		{"AppendHex32Formatted(0xABCDE, group|trim)", AppendHex32Formatted(
+			nil, 0xABCDE, FormatGroupDigits|FormatTrimLeadingZeros), "ABCDE"},
+		{"AppendHex16Formatted(0xABC, group|prefix)", AppendHex16Formatted(
+			nil, 0xABC, FormatGroupDigits|FormatPrefix), "0x0ABC"},
+		{"AppendBinary8(0, trim|group)", AppendBinary8(nil, 0, FormatTrimLeadingZeros|FormatGroupDigits), "0"},
+		{"AppendBinary8(0x2C, prefix|group)", AppendBinary8(nil, 0x2C, FormatPrefix|FormatGroupDigits), "0b0010_1100"},
+		{"AppendBinary16(0x2C5, trim|group)", AppendBinary16(
+			nil, 0x2C5, FormatTrimLeadingZeros|FormatGroupDigits), "10_1100_0101"},
+		{"AppendOctal16(0, trim|prefix)", AppendOctal16(nil, 0, FormatTrimLeadingZeros|FormatPrefix), "0o0"},
+		{"AppendOctal32(0o12345, trim|prefix|group)", AppendOctal32(
+			nil, 0o12345, FormatTrimLeadingZeros|FormatPrefix|FormatGroupDigits), "0o1_2345"},
+		{"AppendUintRadix(0xABCDE, 16, prefix|lower|group)", radix(
+			0xABCDE, 16, FormatPrefix|FormatLowercase|FormatGroupDigits), "0xa_bcde"},
+	} {
+		if string(test.got) != test.want {
+			t.Errorf("%s = %q, want %q", test.name, test.got, test.want)
+		}
+	}
+}
+
+// TestHexEncode checks the separators and the buffer size check of HexEncode and AppendHexEncoded
+func TestHexEncode(t *testing.T) {
+	src := []byte{0xDE, 0xAD, 0xBE, 0xEF}
+	for _, test := range []struct {
+		src       []byte
+		separator []byte
+		want      string
+	}{
+		{nil, nil, ""},
+		{nil, TwoPointsBuffer, ""},
+		{src[:1], TwoPointsBuffer, "DE"},
+		{src, nil, "DEADBEEF"},
+		{src, TwoPointsBuffer, "DE:AD:BE:EF"},
+		{src, WhitespaceBuffer, "DE AD BE EF"},
+		{src, []byte(", "), "DE, AD, BE, EF"},
+	} {
+		if got := string(AppendHexEncoded([]byte("x"), test.src, test.separator)); got != "x"+test.want {
+			t.Errorf("AppendHexEncoded(% X, %q) = %q, want %q", test.src, test.separator, got, "x"+test.want)
+		}
+		if n := HexEncodedLen(len(test.src), test.separator); n != len(test.want) {
+			t.Errorf("HexEncodedLen(%d, %q) = %d, want %d", len(test.src), test.separator, n, len(test.want))
+		}
+
+		// The exact size fits, one byte less is rejected without writing
+		dst := make([]byte, len(test.want))
+		n, err := HexEncode(dst, test.src, test.separator)
+		if err != tinygoerrors.ErrorCodeNil || string(dst[:n]) != test.want {
+			t.Errorf("HexEncode(% X, %q) = %q, %d, want %q", test.src, test.separator, dst[:n], err, test.want)
+		}
+		if len(dst) > 0 {
+			short := make([]byte, len(dst)-1)
+			n, err := HexEncode(short, test.src, test.separator)
+			if n != 0 || err != ErrorCodeBuffersInvalidBufferSize || strings.Trim(string(short), "\x00") != "" {
+				t.Errorf("HexEncode(% X, %q) into a short buffer = %q, %d", test.src, test.separator, short, err)
+			}
+		}
+	}
+}
+
+// TestHexDecode checks the separators and every error path of HexDecode, with the bytes decoded before the error
+func TestHexDecode(t *testing.T) {
+	for _, test := range []struct {
+		src       string
+		separator []byte
+		dstSize   int
+		want      []byte
+		err       tinygoerrors.ErrorCode
+	}{
+		{"", nil, 0, []byte{}, tinygoerrors.ErrorCodeNil},
+		{"", TwoPointsBuffer, 4, []byte{}, tinygoerrors.ErrorCodeNil},
+		{"deADbeEF", nil, 4, []byte{0xDE, 0xAD, 0xBE, 0xEF}, tinygoerrors.ErrorCodeNil},
+		{"DE:AD:BE:EF", TwoPointsBuffer, 4, []byte{0xDE, 0xAD, 0xBE, 0xEF}, tinygoerrors.ErrorCodeNil},
+		{"DE, AD, BE", []byte(", "), 4, []byte{0xDE, 0xAD, 0xBE}, tinygoerrors.ErrorCodeNil},
+		{"DE,AD", []byte(", "), 4, []byte{0xDE}, ErrorCodeBuffersInvalidSeparator},
+		{"DE AD", TwoPointsBuffer, 4, []byte{0xDE}, ErrorCodeBuffersInvalidSeparator},
+		{"DEAD", TwoPointsBuffer, 4, []byte{0xDE}, ErrorCodeBuffersInvalidSeparator},
+		{"DE:AD:", TwoPointsBuffer, 4, []byte{0xDE, 0xAD}, ErrorCodeBuffersIncompleteHexByte},
+		{":DE", TwoPointsBuffer, 4, []byte{}, ErrorCodeBuffersInvalidDigit},
+		{"DEA", nil, 4, []byte{0xDE}, ErrorCodeBuffersIncompleteHexByte},
+		{"DE:A", TwoPointsBuffer, 4, []byte{0xDE}, ErrorCodeBuffersIncompleteHexByte},
+		{"D", nil, 4, []byte{}, ErrorCodeBuffersIncompleteHexByte},
+		{"DEAG", nil, 4, []byte{0xDE}, ErrorCodeBuffersInvalidDigit},
+		{"0x12", nil, 4, []byte{}, ErrorCodeBuffersInvalidDigit},
+		{"DEADBEEF", nil, 3, []byte{0xDE, 0xAD, 0xBE}, ErrorCodeBuffersInvalidBufferSize},
+		{"DE", nil, 0, []byte{}, ErrorCodeBuffersInvalidBufferSize},
+	} {
+		dst := make([]byte, test.dstSize)
+		n, err := HexDecode(dst, []byte(test.src), test.separator)
+		if err != test.err || !bytes.Equal(dst[:n], test.want) {
+			t.Errorf(
+				"HexDecode(%q, %q) = % X, %d, want % X, %d", test.src, test.separator, dst[:n], err, test.want, test.err,
+			)
+		}
+	}
+}
+
+// FuzzHexEncode checks the encoding of random bytes against encoding/hex, with and without a separator, and that it
+// decodes back to the same bytes
+func FuzzHexEncode(f *testing.F) {
+	f.Add([]byte{}, ":")
+	f.Add([]byte{0xDE, 0xAD, 0xBE, 0xEF}, "")
+	f.Add([]byte{0x00, 0xFF}, ", ")
+	f.Fuzz(func(t *testing.T, src []byte, separator string) {
+		// A separator made of hex digits would be ambiguous
+		if strings.ContainsAny(separator, "0123456789ABCDEFabcdef") {
+			return
+		}
+
+		encoded := hex.EncodeToString(src)
+		want := strings.ToUpper(encoded)
+		if separator != "" && len(src) > 1 {
+			parts := make([]string, len(src))
+			for i := range src {
+				parts[i] = want[2*i : 2*i+2]
+			}
+			want = strings.Join(parts, separator)
+		}
+		got := AppendHexEncoded(nil, src, []byte(separator))
+		if string(got) != want {
+			t.Fatalf("AppendHexEncoded(% X, %q) = %q, want %q", src, separator, got, want)
+		}
+
+		decoded := make([]byte, len(src))
+		n, err := HexDecode(decoded, got, []byte(separator))
+		if err != tinygoerrors.ErrorCodeNil || !bytes.Equal(decoded[:n], src) {
+			t.Fatalf("HexDecode(%q, %q) = % X, %d, want % X", got, separator, decoded[:n], err, src)
+		}
+		n, err = HexDecode(decoded, []byte(encoded), nil)
+		if err != tinygoerrors.ErrorCodeNil || !bytes.Equal(decoded[:n], src) {
+			t.Fatalf("HexDecode(%q) = % X, %d, want % X", encoded, decoded[:n], err, src)
+		}
+	})
+}
+
+// FuzzHexDecode checks that HexDecode accepts the same text as encoding/hex without a separator
+func FuzzHexDecode(f *testing.F) {
+	for _, seed := range []string{"", "00", "deADbeEF", "DEA", "0g", "zz"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, src string) {
+		want, wantErr := hex.DecodeString(src)
+		got := make([]byte, len(src)/2)
+		n, err := HexDecode(got, []byte(src), nil)
+		if (wantErr == nil) != (err == tinygoerrors.ErrorCodeNil) {
+			t.Fatalf("HexDecode(%q) returned error %d, encoding/hex %v", src, err, wantErr)
+		}
+		if wantErr == nil && !bytes.Equal(got[:n], want) {
+			t.Fatalf("HexDecode(%q) = % X, want % X", src, got[:n], want)
+		}
+	})
+}
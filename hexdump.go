@@ -0,0 +1,104 @@
+package tinygo_buffers
+
+import (
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+const (
+	// HexdumpBytesPerLine is the number of data bytes rendered in each hexdump line
+	HexdumpBytesPerLine = 16
+
+	// HexdumpLineSize is the size of a full hexdump line: the offset, the hex bytes, the ASCII column and the newline
+	HexdumpLineSize = 8 + 2 + HexdumpBytesPerLine*3 + 1 + 1 + HexdumpBytesPerLine + 2 + 1
+)
+
+// AppendHexdumpLine appends one hexdump line to the given byte slice, like
+// "00000010  48 65 6C 6C 6F 0A 00 01  02 03 04 05 06 07 08 09  |Hello...........|\n"
+//
+// The bytes are grouped by eight, and non-printable bytes are shown as a dot in the ASCII column. A short line is
+// padded so its ASCII column is aligned with the full lines.
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	offset: The offset of the first byte of the line, written as 8 hex digits.
+//	line: The bytes of the line, only the first HexdumpBytesPerLine are used.
+//
+// Returns:
+//
+// The extended byte slice, at most HexdumpLineSize bytes longer. No allocation is made if dst has enough capacity.
+func AppendHexdumpLine(dst []byte, offset uint32, line []byte) []byte {
+	if len(line) > HexdumpBytesPerLine {
+		line = line[:HexdumpBytesPerLine]
+	}
+
+	// Write the offset and the hex column, padding the missing bytes with spaces
+	dst = appendHexDigits(dst, offset, 32, 0, ASCIIHexDigits)
+	dst = append(dst, ' ')
+	for i := 0; i < HexdumpBytesPerLine; i++ {
+		if i%8 == 0 {
+			dst = append(dst, ' ')
+		}
+		if i < len(line) {
+			dst = appendHexByte(dst, line[i], ASCIIHexDigits)
+		} else {
+			dst = append(dst, ' ', ' ')
+		}
+		dst = append(dst, ' ')
+	}
+
+	// Write the printable characters column
+	dst = append(dst, ' ', '|')
+	for _, value := range line {
+		if value < ' ' || value > '~' {
+			value = '.'
+		}
+		dst = append(dst, value)
+	}
+	dst = append(dst, '|')
+	return append(dst, NewlineBuffer...)
+}
+
+// AppendHexdump appends the hexdump of a byte slice to the given byte slice, one line per HexdumpBytesPerLine bytes
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	data: The bytes to dump.
+//	offset: The offset of the first byte, used for the offset column.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendHexdump(dst, data []byte, offset uint32) []byte {
+	for len(data) > 0 {
+		dst = AppendHexdumpLine(dst, offset, data)
+		data = data[min(len(data), HexdumpBytesPerLine):]
+		offset += HexdumpBytesPerLine
+	}
+	return dst
+}
+
+// Hexdump renders the hexdump of a byte slice line by line into a stack buffer and passes each line to a callback, so
+// arbitrarily large buffers can be dumped with HexdumpLineSize bytes of scratch storage
+//
+// Parameters:
+//
+//	data: The bytes to dump.
+//	offset: The offset of the first byte, used for the offset column.
+//	write: The callback receiving each line, including its newline. The line is only valid during the call.
+//
+// Returns:
+//
+// The first error returned by write, which stops the dump, or ErrorCodeNil.
+func Hexdump(data []byte, offset uint32, write func([]byte) tinygoerrors.ErrorCode) tinygoerrors.ErrorCode {
+	var buffer [HexdumpLineSize]byte
+	for len(data) > 0 {
+		if err := write(AppendHexdumpLine(buffer[:0], offset, data)); err != tinygoerrors.ErrorCodeNil {
+			return err
+		}
+		data = data[min(len(data), HexdumpBytesPerLine):]
+		offset += HexdumpBytesPerLine
+	}
+	return tinygoerrors.ErrorCodeNil
+}
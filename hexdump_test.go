@@ -0,0 +1,95 @@
+package tinygo_buffers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// hexdumpWant returns the hexdump of data from encoding/hex.Dump, with upper case hex digits and the offset column
+// starting at the given offset
+func hexdumpWant(data []byte, offset uint32) string {
+	var want strings.Builder
+	for i, line := range strings.SplitAfter(hex.Dump(data), "\n") {
+		if line == "" {
+			continue
+		}
+
+		// The hex column ends at the first bar, the ASCII column is kept as is
+		column := strings.IndexByte(line, '|')
+		lineOffset := offset + uint32(i*HexdumpBytesPerLine)
+		fmt.Fprintf(&want, "%08X%s%s", lineOffset, strings.ToUpper(line[8:column]), line[column:])
+	}
+	return want.String()
+}
+
+// hexdumpData returns n bytes covering printable and non-printable characters
+func hexdumpData(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i*37 + 'A' - 8)
+	}
+	return data
+}
+
+// TestAppendHexdump compares AppendHexdump and Hexdump with encoding/hex.Dump for empty, short, full and partial last
+// lines, at aligned and unaligned offsets
+func TestAppendHexdump(t *testing.T) {
+	for _, n := range []int{0, 1, 15, 16, 17, 33} {
+		data := hexdumpData(n)
+		for _, offset := range []uint32{0, 5, 0x1234567} {
+			want := hexdumpWant(data, offset)
+			if got := string(AppendHexdump([]byte("prefix"), data, offset)); got != "prefix"+want {
+				t.Errorf("AppendHexdump(%d bytes, %#x) =\n%s\nwant\n%s", n, offset, got, want)
+			}
+
+			// The callback lines are never longer than HexdumpLineSize, and only the full lines reach it
+			var got strings.Builder
+			lines := 0
+			err := Hexdump(data, offset, func(line []byte) tinygoerrors.ErrorCode {
+				full := (lines+1)*HexdumpBytesPerLine <= n
+				if len(line) > HexdumpLineSize || full != (len(line) == HexdumpLineSize) {
+					t.Errorf("Hexdump(%d bytes, %#x) line %d is %d bytes: %q", n, offset, lines, len(line), line)
+				}
+				lines++
+				got.Write(line)
+				return tinygoerrors.ErrorCodeNil
+			})
+			if err != tinygoerrors.ErrorCodeNil || got.String() != want {
+				t.Errorf("Hexdump(%d bytes, %#x) = %d,\n%s\nwant\n%s", n, offset, err, got.String(), want)
+			}
+		}
+	}
+}
+
+// TestAppendHexdumpLine checks that the bytes past HexdumpBytesPerLine are ignored and that the offset column wraps
+func TestAppendHexdumpLine(t *testing.T) {
+	data := hexdumpData(HexdumpBytesPerLine + 1)
+	want := hexdumpWant(data[:HexdumpBytesPerLine], 0xFFFFFFF0)
+	if got := string(AppendHexdumpLine(nil, 0xFFFFFFF0, data)); got != want {
+		t.Errorf("AppendHexdumpLine(%d bytes) = %q, want %q", len(data), got, want)
+	}
+	want = hexdumpWant(data, 0xFFFFFFF0)
+	if got := string(AppendHexdump(nil, data, 0xFFFFFFF0)); got != want || !strings.Contains(got, "\n00000000  ") {
+		t.Errorf("AppendHexdump past the last offset = %q, want %q", got, want)
+	}
+}
+
+// TestHexdumpError checks that the first error of the callback stops the dump and is returned
+func TestHexdumpError(t *testing.T) {
+	lines := 0
+	err := Hexdump(hexdumpData(3*HexdumpBytesPerLine), 0, func([]byte) tinygoerrors.ErrorCode {
+		lines++
+		if lines == 2 {
+			return ErrorCodeBuffersInvalidBufferSize
+		}
+		return tinygoerrors.ErrorCodeNil
+	})
+	if err != ErrorCodeBuffersInvalidBufferSize || lines != 2 {
+		t.Fatalf("Hexdump returned error %d after %d lines, want %d after 2 lines", err, lines,
+			ErrorCodeBuffersInvalidBufferSize)
+	}
+}
@@ -0,0 +1,56 @@
+package tinygo_buffers
+
+import (
+	"unsafe"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// PutNE encodes a number in the native byte order, storing the result in the provided buffer, so it can be read back
+// by reinterpreting the memory, like a DMA buffer or a shared-memory structure
+//
+// Parameters:
+//
+//	buffer: A byte slice with at least SizeOf[T]() bytes to store the resulting bytes.
+//	value: The value to convert.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func PutNE[T Number](buffer []byte, value T) tinygoerrors.ErrorCode {
+	if NativeEndian == BigEndian {
+		return PutBE(buffer, value)
+	}
+	return PutLE(buffer, value)
+}
+
+// GetNE decodes a number in the native byte order from a byte slice
+//
+// Parameters:
+//
+//	data: A byte slice containing at least SizeOf[T]() bytes.
+//
+// Returns:
+//
+// The value represented by the first SizeOf[T]() bytes of the input slice, or an error code if the input is invalid.
+func GetNE[T Number](data []byte) (T, tinygoerrors.ErrorCode) {
+	if NativeEndian == BigEndian {
+		return GetBE[T](data)
+	}
+	return GetLE[T](data)
+}
+
+// HostByteOrder detects the byte order of the running host by inspecting the memory of a number. NativeEndian is
+// selected at compile time from GOARCH, so the two only differ on a big-endian target missing from the build
+// constraints, or in a test build with the tinygo_buffers_bigendian tag. Comparing them at startup catches such a build
+//
+// Returns:
+//
+// BigEndian or LittleEndian.
+func HostByteOrder() ByteOrder {
+	value := uint16(1)
+	if *(*byte)(unsafe.Pointer(&value)) == 1 {
+		return LittleEndian
+	}
+	return BigEndian
+}
@@ -0,0 +1,7 @@
+//go:build tinygo_buffers_bigendian || armbe || arm64be || m68k || mips || mips64 || mips64p32 || ppc || ppc64 || s390 || s390x || shbe || sparc || sparc64
+
+package tinygo_buffers
+
+// NativeEndian is the byte order of the target architecture. The tinygo_buffers_bigendian tag selects it on any target,
+// so the big-endian paths can be tested on a little-endian host without an emulator
+const NativeEndian = BigEndian
@@ -0,0 +1,7 @@
+//go:build tinygo_buffers_bigendian
+
+package tinygo_buffers
+
+func init() {
+	forcedBigEndian = true
+}
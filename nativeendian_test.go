@@ -0,0 +1,93 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"unsafe"
+)
+
+// forcedBigEndian is set by nativeendian_forced_test.go when the tinygo_buffers_bigendian tag forces NativeEndian.
+// Run the tests with and without the tag to cover both byte orders on a little-endian host:
+//
+//	go test ./...
+//	go test -tags tinygo_buffers_bigendian ./...
+var forcedBigEndian bool
+
+// checkNE checks that PutNE writes the bytes of the encoder matching NativeEndian and that GetNE reads them back
+func checkNE[T Number](t *testing.T, value T) {
+	t.Helper()
+	size := SizeOf[T]()
+	native, big, little := make([]byte, size), make([]byte, size), make([]byte, size)
+	if err := PutNE(native, value); err != 0 {
+		t.Fatalf("PutNE(%v) returned error %d", value, err)
+	}
+	PutBE(big, value)
+	PutLE(little, value)
+
+	want := little
+	if NativeEndian == BigEndian {
+		want = big
+	}
+	if !bytes.Equal(native, want) {
+		t.Errorf("PutNE(%v) = % X, want % X", value, native, want)
+	}
+	if got, err := GetNE[T](native); err != 0 || got != value {
+		t.Errorf("GetNE(% X) = %v, %d, want %v", native, got, err, value)
+	}
+
+	// Short buffers are rejected like the fixed-order functions
+	if err := PutNE(native[:size-1], value); err != ErrorCodeBuffersInvalidBufferSize {
+		t.Errorf("PutNE with %d bytes returned error %d", size-1, err)
+	}
+	if _, err := GetNE[T](native[:size-1]); err != ErrorCodeBuffersInvalidBufferSize {
+		t.Errorf("GetNE with %d bytes returned error %d", size-1, err)
+	}
+}
+
+// TestNE checks PutNE and GetNE for every Number type
+func TestNE(t *testing.T) {
+	checkNE(t, uint8(0xA5))
+	checkNE(t, int8(-2))
+	checkNE(t, uint16(0x0102))
+	checkNE(t, int16(-0x0102))
+	checkNE(t, uint32(0x01020304))
+	checkNE(t, int32(-0x01020304))
+	checkNE(t, uint64(0x0102030405060708))
+	checkNE(t, int64(-0x0102030405060708))
+	checkNE(t, float32(-1.5))
+	checkNE(t, float64(math.Pi))
+}
+
+// TestNEMatchesMemory checks that, unless the byte order is forced, PutNE writes the bytes the host stores in memory
+func TestNEMatchesMemory(t *testing.T) {
+	if forcedBigEndian {
+		t.Skip("NativeEndian is forced by the tinygo_buffers_bigendian tag")
+	}
+	value := uint64(0x0102030405060708)
+	var buffer [8]byte
+	PutNE(buffer[:], value)
+	if memory := *(*[8]byte)(unsafe.Pointer(&value)); buffer != memory {
+		t.Errorf("PutNE(%#x) = % X, memory holds % X", value, buffer, memory)
+	}
+}
+
+// TestHostByteOrder checks HostByteOrder against encoding/binary and NativeEndian
+func TestHostByteOrder(t *testing.T) {
+	host := LittleEndian
+	if binary.NativeEndian.Uint16([]byte{0, 1}) == 1 {
+		host = BigEndian
+	}
+	if got := HostByteOrder(); got != host {
+		t.Fatalf("HostByteOrder() = %d, want %d", got, host)
+	}
+
+	want := host
+	if forcedBigEndian {
+		want = BigEndian
+	}
+	if NativeEndian != want {
+		t.Errorf("NativeEndian = %d, want %d", NativeEndian, want)
+	}
+}
@@ -0,0 +1,183 @@
+package tinygo_buffers
+
+import (
+	"math"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// siPrefixes contains the SI prefixes from 10^-24 to 10^24 in steps of 10^3, with a placeholder for 10^0. Micro is
+// written as 'u' so the output stays ASCII
+const siPrefixes = "yzafpnum kMGTPEZY"
+
+// float64NotationOverhead is the maximum number of bytes, other than the significant digits, in the scientific and
+// engineering notations: the sign, the dot and an exponent like "e-308"
+const float64NotationOverhead = 7
+
+// AppendFloat64Scientific appends the scientific notation of a float64 value to the given byte slice, like "1.234e-09"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//	digits: The number of significant digits, at least 1.
+//
+// Returns:
+//
+// The extended byte slice, rounded half to even. No allocation is made if dst has enough capacity.
+func AppendFloat64Scientific(dst []byte, value float64, digits int) []byte {
+	// Check for the special values
+	if special, ok := appendFloat64Special(dst, value); ok {
+		return special
+	}
+	if digits < 1 {
+		digits = 1
+	}
+
+	var storage [decimalFormatDigits + decimalShiftSlack]byte
+	d := decimal{d: storage[:]}
+	d.assignFloat(math.Float64bits(value), &float64Info)
+	d.round(digits)
+	return d.appendExponent(dst, digits-1)
+}
+
+// AppendFloat64Engineering appends the engineering notation of a float64 value with an SI prefix to the given byte
+// slice, like "4.7k" or "1.5n"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//	digits: The number of significant digits, at least 1. Up to 3 integer digits are written even if there are fewer
+//	significant digits.
+//
+// Returns:
+//
+// The extended byte slice, rounded half to even. Values beyond the range of the SI prefixes use an exponent multiple
+// of 3, like "1.5e+27". No allocation is made if dst has enough capacity.
+func AppendFloat64Engineering(dst []byte, value float64, digits int) []byte {
+	// Check for the special values
+	if special, ok := appendFloat64Special(dst, value); ok {
+		return special
+	}
+	if digits < 1 {
+		digits = 1
+	}
+
+	var storage [decimalFormatDigits + decimalShiftSlack]byte
+	d := decimal{d: storage[:]}
+	d.assignFloat(math.Float64bits(value), &float64Info)
+	d.round(digits)
+
+	// Pick the exponent multiple of 3 that leaves 1 to 3 integer digits
+	exp := 0
+	if d.nd > 0 {
+		exp = d.dp - 1
+		exp -= ((exp % 3) + 3) % 3
+	}
+	d.dp -= exp
+	intDigits := 1
+	if d.nd > 0 {
+		intDigits = d.dp
+	}
+	precision := digits - intDigits
+	if precision < 0 {
+		precision = 0
+	}
+	dst = d.appendFixed(dst, precision)
+
+	// Write the prefix, or the exponent when there is no prefix for it
+	switch index := exp/3 + len(siPrefixes)/2; {
+	case exp == 0:
+	case index >= 0 && index < len(siPrefixes):
+		dst = append(dst, siPrefixes[index])
+	default:
+		dst = append(dst, 'e')
+		if exp < 0 {
+			dst = append(dst, '-')
+			exp = -exp
+		} else {
+			dst = append(dst, '+')
+		}
+		dst = AppendUintDecimal(dst, uint64(exp))
+	}
+	return dst
+}
+
+// Float64ToScientific converts a float64 value to its scientific notation, like "1.234e-09"
+//
+// Parameters:
+//
+//	value: The float64 value to convert.
+//	digits: The number of significant digits, at least 1.
+//
+// Returns:
+//
+// A byte slice representing the scientific notation of the float64 value and an error code indicating success or
+// failure.
+func (f *Formatter) Float64ToScientific(value float64, digits int) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	if digits+float64NotationOverhead > Float64ToDecimalBufferSize {
+		return nil, ErrorCodeBuffersTooMuchPrecisionDigits
+	}
+	return AppendFloat64Scientific(f.floatToDecimalBuffer[:0], value, digits), tinygoerrors.ErrorCodeNil
+}
+
+// Float64ToEngineering converts a float64 value to its engineering notation with an SI prefix, like "4.7k"
+//
+// Parameters:
+//
+//	value: The float64 value to convert.
+//	digits: The number of significant digits, at least 1.
+//
+// Returns:
+//
+// A byte slice representing the engineering notation of the float64 value and an error code indicating success or
+// failure.
+func (f *Formatter) Float64ToEngineering(value float64, digits int) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	if digits+float64NotationOverhead > Float64ToDecimalBufferSize {
+		return nil, ErrorCodeBuffersTooMuchPrecisionDigits
+	}
+	return AppendFloat64Engineering(f.floatToDecimalBuffer[:0], value, digits), tinygoerrors.ErrorCodeNil
+}
+
+// Float64ToScientific converts a float64 value to its scientific notation, like "1.234e-09"
+//
+// Parameters:
+//
+//	value: The float64 value to convert.
+//	digits: The number of significant digits, at least 1.
+//
+// Returns:
+//
+// A byte slice representing the scientific notation of the float64 value and an error code indicating success or
+// failure.
+func Float64ToScientific(value float64, digits int) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	return defaultFormatter.Float64ToScientific(value, digits)
+}
+
+// Float64ToEngineering converts a float64 value to its engineering notation with an SI prefix, like "4.7k"
+//
+// Parameters:
+//
+//	value: The float64 value to convert.
+//	digits: The number of significant digits, at least 1.
+//
+// Returns:
+//
+// A byte slice representing the engineering notation of the float64 value and an error code indicating success or
+// failure.
+func Float64ToEngineering(value float64, digits int) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	return defaultFormatter.Float64ToEngineering(value, digits)
+}
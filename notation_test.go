@@ -0,0 +1,119 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// TestAppendFloat64Scientific checks the special values, the rounding carry into the next exponent, and random
+// values against strconv
+func TestAppendFloat64Scientific(t *testing.T) {
+	for _, test := range []struct {
+		value  float64
+		digits int
+		want   string
+	}{
+		{9.995e2, 3, "1.00e+03"},
+		{9.985e2, 3, "9.98e+02"},
+		{9.5, 1, "1e+01"},
+		{0, 3, "0.00e+00"},
+		{math.Copysign(0, -1), 3, "-0.00e+00"},
+		{0, 0, "0e+00"},
+		{math.NaN(), 3, "NaN"},
+		{math.Inf(1), 3, "+Inf"},
+		{math.Inf(-1), 3, "-Inf"},
+		{5e-324, 3, "4.94e-324"},
+		{-2.2250738585072009e-308, 4, "-2.225e-308"},
+		{math.MaxFloat64, 2, "1.8e+308"},
+	} {
+		if got := string(AppendFloat64Scientific(nil, test.value, test.digits)); got != test.want {
+			t.Errorf("AppendFloat64Scientific(%v, %d) = %q, want %q", test.value, test.digits, got, test.want)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		value := math.Float64frombits(rng.Uint64())
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+		digits := rng.Intn(17) + 1
+		got := string(AppendFloat64Scientific(nil, value, digits))
+		if want := strconv.FormatFloat(value, 'e', digits-1, 64); got != want {
+			t.Fatalf("AppendFloat64Scientific(%v, %d) = %q, want %q", value, digits, got, want)
+		}
+	}
+}
+
+// TestAppendFloat64Engineering checks the SI prefixes up to their edges, the fallback to an exponent beyond them,
+// the special values, and the rounding carry into the next group of three digits
+func TestAppendFloat64Engineering(t *testing.T) {
+	for _, test := range []struct {
+		value  float64
+		digits int
+		want   string
+	}{
+		{4700, 2, "4.7k"},
+		{-1.5e-9, 3, "-1.50n"},
+		{1.5e-6, 2, "1.5u"},
+		{0.001, 3, "1.00m"},
+		{123456, 3, "123k"},
+		{123456, 1, "100k"},
+		{12.5, 3, "12.5"},
+		{999.95, 4, "1.000k"},
+		{999.95, 5, "999.95"},
+		{999.5, 3, "1.00k"},
+		{0.99995, 4, "1.000"},
+		{0, 3, "0.00"},
+		{math.Copysign(0, -1), 3, "-0.00"},
+		{math.NaN(), 3, "NaN"},
+		{math.Inf(1), 3, "+Inf"},
+		{math.Inf(-1), 3, "-Inf"},
+
+		// The edges of the SI prefixes
+		{1e-24, 3, "1.00y"},
+		{9.9999e-25, 3, "1.00y"},
+		{9.99e-25, 3, "999e-27"},
+		{1e-25, 3, "100e-27"},
+		{1e24, 3, "1.00Y"},
+		{999.4e24, 4, "999.4Y"},
+		{999.99e24, 3, "1.00e+27"},
+		{1e27, 3, "1.00e+27"},
+		{-1.5e30, 2, "-1.5e+30"},
+
+		// Subnormals
+		{5e-324, 3, "4.94e-324"},
+		{2.2250738585072009e-308, 3, "22.3e-309"},
+	} {
+		if got := string(AppendFloat64Engineering(nil, test.value, test.digits)); got != test.want {
+			t.Errorf("AppendFloat64Engineering(%v, %d) = %q, want %q", test.value, test.digits, got, test.want)
+		}
+	}
+}
+
+// TestFloat64NotationBufferLimit checks that the longest output of the largest number of digits accepted by the
+// Formatter fits in its buffer, and that one more digit is rejected
+func TestFloat64NotationBufferLimit(t *testing.T) {
+	const digits = Float64ToDecimalBufferSize - float64NotationOverhead
+	var f Formatter
+	for _, value := range []float64{-2.2250738585072014e-308, -1.2345678901234567e-300, -math.MaxFloat64} {
+		scientific, err := f.Float64ToScientific(value, digits)
+		if err != tinygoerrors.ErrorCodeNil || len(scientific) > Float64ToDecimalBufferSize {
+			t.Errorf("Float64ToScientific(%v, %d) = %q, %d", value, digits, scientific, err)
+		}
+		engineering, err := f.Float64ToEngineering(value, digits)
+		if err != tinygoerrors.ErrorCodeNil || len(engineering) > Float64ToDecimalBufferSize {
+			t.Errorf("Float64ToEngineering(%v, %d) = %q, %d", value, digits, engineering, err)
+		}
+	}
+	if _, err := f.Float64ToScientific(1, digits+1); err != ErrorCodeBuffersTooMuchPrecisionDigits {
+		t.Errorf("Float64ToScientific(1, %d) returned error %d", digits+1, err)
+	}
+	if _, err := f.Float64ToEngineering(1, digits+1); err != ErrorCodeBuffersTooMuchPrecisionDigits {
+		t.Errorf("Float64ToEngineering(1, %d) returned error %d", digits+1, err)
+	}
+}
@@ -0,0 +1,1013 @@
+package tinygo_buffers
+
+import (
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// bufferAt returns the bytes of a field at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field.
+//	size: The size of the field in bytes.
+//
+// Returns:
+//
+// The bytes of the field, or an error code if the field does not fit in the buffer.
+func bufferAt(buffer []byte, offset int, size int) ([]byte, tinygoerrors.ErrorCode) {
+	if offset < 0 || offset > len(buffer)-size {
+		return nil, ErrorCodeBuffersInvalidBufferSize
+	}
+	return buffer[offset : offset+size], tinygoerrors.ErrorCodeNil
+}
+
+// GetUint8At reads an uint8 value at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint8 value, or an error code if offset+1 exceeds the buffer.
+func GetUint8At(buffer []byte, offset int) (uint8, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 1)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return uint8(data[0]), tinygoerrors.ErrorCodeNil
+}
+
+// PutUint8At writes an uint8 value at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint8 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+1 exceeds the
+// buffer.
+func PutUint8At(buffer []byte, offset int, value uint8) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 1)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	data[0] = byte(value)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetInt8At reads an int8 value at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int8 value, or an error code if offset+1 exceeds the buffer.
+func GetInt8At(buffer []byte, offset int) (int8, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 1)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return int8(data[0]), tinygoerrors.ErrorCodeNil
+}
+
+// PutInt8At writes an int8 value at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int8 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+1 exceeds the
+// buffer.
+func PutInt8At(buffer []byte, offset int, value int8) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 1)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	data[0] = byte(value)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// GetUint16At reads an uint16 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint16 value, or an error code if offset+2 exceeds the buffer.
+func GetUint16At(buffer []byte, offset int) (uint16, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 2)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint16(data)
+}
+
+// PutUint16At writes an uint16 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint16 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+2 exceeds the
+// buffer.
+func PutUint16At(buffer []byte, offset int, value uint16) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 2)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint16ToBytes(value, data)
+}
+
+// GetUint16LEAt reads an uint16 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint16 value, or an error code if offset+2 exceeds the buffer.
+func GetUint16LEAt(buffer []byte, offset int) (uint16, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 2)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint16LE(data)
+}
+
+// PutUint16LEAt writes an uint16 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint16 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+2 exceeds the
+// buffer.
+func PutUint16LEAt(buffer []byte, offset int, value uint16) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 2)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint16ToBytesLE(value, data)
+}
+
+// GetInt16At reads an int16 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int16 value, or an error code if offset+2 exceeds the buffer.
+func GetInt16At(buffer []byte, offset int) (int16, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 2)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt16(data)
+}
+
+// PutInt16At writes an int16 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int16 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+2 exceeds the
+// buffer.
+func PutInt16At(buffer []byte, offset int, value int16) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 2)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int16ToBytes(value, data)
+}
+
+// GetInt16LEAt reads an int16 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int16 value, or an error code if offset+2 exceeds the buffer.
+func GetInt16LEAt(buffer []byte, offset int) (int16, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 2)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt16LE(data)
+}
+
+// PutInt16LEAt writes an int16 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int16 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+2 exceeds the
+// buffer.
+func PutInt16LEAt(buffer []byte, offset int, value int16) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 2)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int16ToBytesLE(value, data)
+}
+
+// GetUint24At reads an uint32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint32 value, or an error code if offset+3 exceeds the buffer.
+func GetUint24At(buffer []byte, offset int) (uint32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 3)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint24(data)
+}
+
+// PutUint24At writes an uint32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+3 exceeds the
+// buffer.
+func PutUint24At(buffer []byte, offset int, value uint32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 3)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint24ToBytes(value, data)
+}
+
+// GetUint24LEAt reads an uint32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint32 value, or an error code if offset+3 exceeds the buffer.
+func GetUint24LEAt(buffer []byte, offset int) (uint32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 3)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint24LE(data)
+}
+
+// PutUint24LEAt writes an uint32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+3 exceeds the
+// buffer.
+func PutUint24LEAt(buffer []byte, offset int, value uint32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 3)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint24ToBytesLE(value, data)
+}
+
+// GetInt24At reads an int32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int32 value, or an error code if offset+3 exceeds the buffer.
+func GetInt24At(buffer []byte, offset int) (int32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 3)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt24(data)
+}
+
+// PutInt24At writes an int32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+3 exceeds the
+// buffer.
+func PutInt24At(buffer []byte, offset int, value int32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 3)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int24ToBytes(value, data)
+}
+
+// GetInt24LEAt reads an int32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int32 value, or an error code if offset+3 exceeds the buffer.
+func GetInt24LEAt(buffer []byte, offset int) (int32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 3)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt24LE(data)
+}
+
+// PutInt24LEAt writes an int32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+3 exceeds the
+// buffer.
+func PutInt24LEAt(buffer []byte, offset int, value int32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 3)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int24ToBytesLE(value, data)
+}
+
+// GetUint32At reads an uint32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint32 value, or an error code if offset+4 exceeds the buffer.
+func GetUint32At(buffer []byte, offset int) (uint32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint32(data)
+}
+
+// PutUint32At writes an uint32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+4 exceeds the
+// buffer.
+func PutUint32At(buffer []byte, offset int, value uint32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint32ToBytes(value, data)
+}
+
+// GetUint32LEAt reads an uint32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint32 value, or an error code if offset+4 exceeds the buffer.
+func GetUint32LEAt(buffer []byte, offset int) (uint32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint32LE(data)
+}
+
+// PutUint32LEAt writes an uint32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+4 exceeds the
+// buffer.
+func PutUint32LEAt(buffer []byte, offset int, value uint32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint32ToBytesLE(value, data)
+}
+
+// GetInt32At reads an int32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int32 value, or an error code if offset+4 exceeds the buffer.
+func GetInt32At(buffer []byte, offset int) (int32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt32(data)
+}
+
+// PutInt32At writes an int32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+4 exceeds the
+// buffer.
+func PutInt32At(buffer []byte, offset int, value int32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int32ToBytes(value, data)
+}
+
+// GetInt32LEAt reads an int32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int32 value, or an error code if offset+4 exceeds the buffer.
+func GetInt32LEAt(buffer []byte, offset int) (int32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt32LE(data)
+}
+
+// PutInt32LEAt writes an int32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+4 exceeds the
+// buffer.
+func PutInt32LEAt(buffer []byte, offset int, value int32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int32ToBytesLE(value, data)
+}
+
+// GetUint48At reads an uint64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint64 value, or an error code if offset+6 exceeds the buffer.
+func GetUint48At(buffer []byte, offset int) (uint64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 6)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint48(data)
+}
+
+// PutUint48At writes an uint64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+6 exceeds the
+// buffer.
+func PutUint48At(buffer []byte, offset int, value uint64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 6)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint48ToBytes(value, data)
+}
+
+// GetUint48LEAt reads an uint64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint64 value, or an error code if offset+6 exceeds the buffer.
+func GetUint48LEAt(buffer []byte, offset int) (uint64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 6)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint48LE(data)
+}
+
+// PutUint48LEAt writes an uint64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+6 exceeds the
+// buffer.
+func PutUint48LEAt(buffer []byte, offset int, value uint64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 6)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint48ToBytesLE(value, data)
+}
+
+// GetInt48At reads an int64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int64 value, or an error code if offset+6 exceeds the buffer.
+func GetInt48At(buffer []byte, offset int) (int64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 6)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt48(data)
+}
+
+// PutInt48At writes an int64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+6 exceeds the
+// buffer.
+func PutInt48At(buffer []byte, offset int, value int64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 6)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int48ToBytes(value, data)
+}
+
+// GetInt48LEAt reads an int64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int64 value, or an error code if offset+6 exceeds the buffer.
+func GetInt48LEAt(buffer []byte, offset int) (int64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 6)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt48LE(data)
+}
+
+// PutInt48LEAt writes an int64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+6 exceeds the
+// buffer.
+func PutInt48LEAt(buffer []byte, offset int, value int64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 6)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int48ToBytesLE(value, data)
+}
+
+// GetUint64At reads an uint64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint64 value, or an error code if offset+8 exceeds the buffer.
+func GetUint64At(buffer []byte, offset int) (uint64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint64(data)
+}
+
+// PutUint64At writes an uint64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+8 exceeds the
+// buffer.
+func PutUint64At(buffer []byte, offset int, value uint64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint64ToBytes(value, data)
+}
+
+// GetUint64LEAt reads an uint64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The uint64 value, or an error code if offset+8 exceeds the buffer.
+func GetUint64LEAt(buffer []byte, offset int) (uint64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToUint64LE(data)
+}
+
+// PutUint64LEAt writes an uint64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The uint64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+8 exceeds the
+// buffer.
+func PutUint64LEAt(buffer []byte, offset int, value uint64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Uint64ToBytesLE(value, data)
+}
+
+// GetInt64At reads an int64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int64 value, or an error code if offset+8 exceeds the buffer.
+func GetInt64At(buffer []byte, offset int) (int64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt64(data)
+}
+
+// PutInt64At writes an int64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+8 exceeds the
+// buffer.
+func PutInt64At(buffer []byte, offset int, value int64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int64ToBytes(value, data)
+}
+
+// GetInt64LEAt reads an int64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The int64 value, or an error code if offset+8 exceeds the buffer.
+func GetInt64LEAt(buffer []byte, offset int) (int64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToInt64LE(data)
+}
+
+// PutInt64LEAt writes an int64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The int64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+8 exceeds the
+// buffer.
+func PutInt64LEAt(buffer []byte, offset int, value int64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Int64ToBytesLE(value, data)
+}
+
+// GetFloat32At reads a float32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The float32 value, or an error code if offset+4 exceeds the buffer.
+func GetFloat32At(buffer []byte, offset int) (float32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToFloat32(data)
+}
+
+// PutFloat32At writes a float32 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The float32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+4 exceeds the
+// buffer.
+func PutFloat32At(buffer []byte, offset int, value float32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Float32ToBytes(value, data)
+}
+
+// GetFloat32LEAt reads a float32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The float32 value, or an error code if offset+4 exceeds the buffer.
+func GetFloat32LEAt(buffer []byte, offset int) (float32, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToFloat32LE(data)
+}
+
+// PutFloat32LEAt writes a float32 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The float32 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+4 exceeds the
+// buffer.
+func PutFloat32LEAt(buffer []byte, offset int, value float32) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 4)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Float32ToBytesLE(value, data)
+}
+
+// GetFloat64At reads a float64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The float64 value, or an error code if offset+8 exceeds the buffer.
+func GetFloat64At(buffer []byte, offset int) (float64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToFloat64(data)
+}
+
+// PutFloat64At writes a float64 value in big-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The float64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+8 exceeds the
+// buffer.
+func PutFloat64At(buffer []byte, offset int, value float64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Float64ToBytes(value, data)
+}
+
+// GetFloat64LEAt reads a float64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//
+// Returns:
+//
+// The float64 value, or an error code if offset+8 exceeds the buffer.
+func GetFloat64LEAt(buffer []byte, offset int) (float64, tinygoerrors.ErrorCode) {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return BytesToFloat64LE(data)
+}
+
+// PutFloat64LEAt writes a float64 value in little-endian order at the given offset of a buffer
+//
+// Parameters:
+//
+//	buffer: The buffer holding the field.
+//	offset: The offset of the field in bytes.
+//	value: The float64 value to write.
+//
+// Returns:
+//
+// An error code indicating success or failure, which is ErrorCodeBuffersInvalidBufferSize if offset+8 exceeds the
+// buffer.
+func PutFloat64LEAt(buffer []byte, offset int, value float64) tinygoerrors.ErrorCode {
+	data, err := bufferAt(buffer, offset, 8)
+	if err != tinygoerrors.ErrorCodeNil {
+		return err
+	}
+	return Float64ToBytesLE(value, data)
+}
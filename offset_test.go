@@ -0,0 +1,112 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// offsetBufferSize is the size of the buffer used to check the offset accessors
+const offsetBufferSize = 11
+
+// checkAt checks an accessor pair with a field that ends exactly at the end of the buffer, and rejects offsets that
+// are negative or run past the end, including those whose sum with the size overflows
+//
+// Parameters:
+//
+//	t: The test.
+//	name: The name of the accessors, without the Get or Put prefix.
+//	value: The value to write.
+//	want: The encoding of the value.
+//	put: The Put accessor.
+//	get: The Get accessor.
+func checkAt[T comparable](
+	t *testing.T,
+	name string,
+	value T,
+	want []byte,
+	put func([]byte, int, T) tinygoerrors.ErrorCode,
+	get func([]byte, int) (T, tinygoerrors.ErrorCode),
+) {
+	t.Helper()
+	buffer := bytes.Repeat([]byte{0xA5}, offsetBufferSize)
+	offset := offsetBufferSize - len(want)
+
+	// The last field fits exactly, and only its bytes are written
+	if err := put(buffer, offset, value); err != tinygoerrors.ErrorCodeNil {
+		t.Fatalf("Put%s at offset %d returned error %d", name, offset, err)
+	}
+	if wantBuffer := append(bytes.Repeat([]byte{0xA5}, offset), want...); !bytes.Equal(buffer, wantBuffer) {
+		t.Fatalf("Put%s at offset %d = % X, want % X", name, offset, buffer, wantBuffer)
+	}
+	if got, err := get(buffer, offset); got != value || err != tinygoerrors.ErrorCodeNil {
+		t.Fatalf("Get%s at offset %d = %v, %d, want %v", name, offset, got, err, value)
+	}
+	if got, err := get(buffer[offset:], 0); got != value || err != tinygoerrors.ErrorCodeNil {
+		t.Fatalf("Get%s of a buffer of its size = %v, %d, want %v", name, got, err, value)
+	}
+
+	// The rejected offsets leave the buffer unchanged
+	saved := bytes.Clone(buffer)
+	rejected := []int{-1, offset + 1, offsetBufferSize, math.MaxInt, math.MaxInt - len(want) + 1, math.MinInt}
+	for _, offset := range rejected {
+		if err := put(buffer, offset, value); err != ErrorCodeBuffersInvalidBufferSize {
+			t.Errorf("Put%s at offset %d returned error %d", name, offset, err)
+		}
+		if got, err := get(buffer, offset); err != ErrorCodeBuffersInvalidBufferSize || got != *new(T) {
+			t.Errorf("Get%s at offset %d = %v, %d", name, offset, got, err)
+		}
+	}
+	if _, err := get(buffer[:len(want)-1], 0); err != ErrorCodeBuffersInvalidBufferSize {
+		t.Errorf("Get%s of a buffer one byte short returned error %d", name, err)
+	}
+	if _, err := get(nil, 0); err != ErrorCodeBuffersInvalidBufferSize {
+		t.Errorf("Get%s of a nil buffer returned error %d", name, err)
+	}
+	if !bytes.Equal(buffer, saved) {
+		t.Errorf("rejected Put%s changed the buffer to % X", name, buffer)
+	}
+}
+
+// TestOffsetAccessors checks every offset accessor pair with a known encoding
+func TestOffsetAccessors(t *testing.T) {
+	checkAt(t, "Uint8At", uint8(0xAB), []byte{0xAB}, PutUint8At, GetUint8At)
+	checkAt(t, "Int8At", int8(-2), []byte{0xFE}, PutInt8At, GetInt8At)
+	checkAt(t, "Uint16At", uint16(0x1234), []byte{0x12, 0x34}, PutUint16At, GetUint16At)
+	checkAt(t, "Uint16LEAt", uint16(0x1234), []byte{0x34, 0x12}, PutUint16LEAt, GetUint16LEAt)
+	checkAt(t, "Int16At", int16(-2), []byte{0xFF, 0xFE}, PutInt16At, GetInt16At)
+	checkAt(t, "Int16LEAt", int16(-2), []byte{0xFE, 0xFF}, PutInt16LEAt, GetInt16LEAt)
+	checkAt(t, "Uint24At", uint32(0x123456), []byte{0x12, 0x34, 0x56}, PutUint24At, GetUint24At)
+	checkAt(t, "Uint24LEAt", uint32(0x123456), []byte{0x56, 0x34, 0x12}, PutUint24LEAt, GetUint24LEAt)
+	checkAt(t, "Int24At", int32(-8388608), []byte{0x80, 0x00, 0x00}, PutInt24At, GetInt24At)
+	checkAt(t, "Int24LEAt", int32(-2), []byte{0xFE, 0xFF, 0xFF}, PutInt24LEAt, GetInt24LEAt)
+	checkAt(t, "Uint32At", uint32(0x11223344), []byte{0x11, 0x22, 0x33, 0x44}, PutUint32At, GetUint32At)
+	checkAt(t, "Uint32LEAt", uint32(0x11223344), []byte{0x44, 0x33, 0x22, 0x11}, PutUint32LEAt, GetUint32LEAt)
+	checkAt(t, "Int32At", int32(-2), []byte{0xFF, 0xFF, 0xFF, 0xFE}, PutInt32At, GetInt32At)
+	checkAt(t, "Int32LEAt", int32(-2), []byte{0xFE, 0xFF, 0xFF, 0xFF}, PutInt32LEAt, GetInt32LEAt)
+	checkAt(
+		t, "Uint48At", uint64(0x123456789ABC), []byte{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}, PutUint48At, GetUint48At,
+	)
+	checkAt(
+		t, "Uint48LEAt", uint64(0x123456789ABC), []byte{0xBC, 0x9A, 0x78, 0x56, 0x34, 0x12}, PutUint48LEAt,
+		GetUint48LEAt,
+	)
+	checkAt(t, "Int48At", int64(-1<<47), []byte{0x80, 0, 0, 0, 0, 0}, PutInt48At, GetInt48At)
+	checkAt(t, "Int48LEAt", int64(-2), []byte{0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, PutInt48LEAt, GetInt48LEAt)
+	checkAt(
+		t, "Uint64At", uint64(0x0102030405060708), []byte{1, 2, 3, 4, 5, 6, 7, 8}, PutUint64At, GetUint64At,
+	)
+	checkAt(
+		t, "Uint64LEAt", uint64(0x0102030405060708), []byte{8, 7, 6, 5, 4, 3, 2, 1}, PutUint64LEAt, GetUint64LEAt,
+	)
+	checkAt(t, "Int64At", int64(math.MinInt64), []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, PutInt64At, GetInt64At)
+	checkAt(
+		t, "Int64LEAt", int64(-2), []byte{0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, PutInt64LEAt, GetInt64LEAt,
+	)
+	checkAt(t, "Float32At", float32(1.5), []byte{0x3F, 0xC0, 0x00, 0x00}, PutFloat32At, GetFloat32At)
+	checkAt(t, "Float32LEAt", float32(-1), []byte{0x00, 0x00, 0x80, 0xBF}, PutFloat32LEAt, GetFloat32LEAt)
+	checkAt(t, "Float64At", 1.5, []byte{0x3F, 0xF8, 0, 0, 0, 0, 0, 0}, PutFloat64At, GetFloat64At)
+	checkAt(t, "Float64LEAt", -1.0, []byte{0, 0, 0, 0, 0, 0, 0xF0, 0xBF}, PutFloat64LEAt, GetFloat64LEAt)
+}
@@ -0,0 +1,415 @@
+package tinygo_buffers
+
+import (
+	"math"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// ParseUintDecimal parses the decimal representation of an uint64 value
+//
+// Parameters:
+//
+//	data: A byte slice containing only decimal digits.
+//
+// Returns:
+//
+// The parsed uint64 value and an error code indicating success or failure.
+func ParseUintDecimal(data []byte) (uint64, tinygoerrors.ErrorCode) {
+	return parseUintDecimal(data, 1<<64-1)
+}
+
+// ParseIntDecimal parses the decimal representation of an int64 value
+//
+// Parameters:
+//
+//	data: A byte slice containing decimal digits, optionally preceded by a '+' or '-' sign.
+//
+// Returns:
+//
+// The parsed int64 value and an error code indicating success or failure.
+func ParseIntDecimal(data []byte) (int64, tinygoerrors.ErrorCode) {
+	negative := false
+	if len(data) > 0 && (data[0] == '-' || data[0] == '+') {
+		negative = data[0] == '-'
+		data = data[1:]
+	}
+
+	// The magnitude of a negative value can be one more than the maximum positive value
+	if negative {
+		u, err := parseUintDecimal(data, 1<<63)
+		if err != tinygoerrors.ErrorCodeNil {
+			return 0, err
+		}
+		return -int64(u), tinygoerrors.ErrorCodeNil
+	}
+	u, err := parseUintDecimal(data, 1<<63-1)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return int64(u), tinygoerrors.ErrorCodeNil
+}
+
+// parseUintDecimal parses decimal digits into an uint64 value not greater than the given maximum
+//
+// Parameters:
+//
+//	data: A byte slice containing only decimal digits.
+//	max: The maximum value allowed.
+//
+// Returns:
+//
+// The parsed uint64 value and an error code indicating success or failure.
+func parseUintDecimal(data []byte, max uint64) (uint64, tinygoerrors.ErrorCode) {
+	if len(data) == 0 {
+		return 0, ErrorCodeBuffersEmptyInput
+	}
+
+	var value uint64
+	for _, c := range data {
+		if c < '0' || c > '9' {
+			return 0, ErrorCodeBuffersInvalidDigit
+		}
+		digit := uint64(c - '0')
+
+		// Check that value*10 + digit does not exceed the maximum
+		if value > (max-digit)/10 {
+			return 0, ErrorCodeBuffersValueOverflow
+		}
+		value = value*10 + digit
+	}
+	return value, tinygoerrors.ErrorCodeNil
+}
+
+// ParseHex8 parses the hexadecimal representation of an uint8 value
+//
+// Parameters:
+//
+//	data: A byte slice containing hex digits, optionally preceded by HexPrefix.
+//
+// Returns:
+//
+// The parsed uint8 value and an error code indicating success or failure.
+func ParseHex8(data []byte) (uint8, tinygoerrors.ErrorCode) {
+	value, err := parseHex(data, 8)
+	return uint8(value), err
+}
+
+// ParseHex16 parses the hexadecimal representation of an uint16 value
+//
+// Parameters:
+//
+//	data: A byte slice containing hex digits, optionally preceded by HexPrefix.
+//
+// Returns:
+//
+// The parsed uint16 value and an error code indicating success or failure.
+func ParseHex16(data []byte) (uint16, tinygoerrors.ErrorCode) {
+	value, err := parseHex(data, 16)
+	return uint16(value), err
+}
+
+// ParseHex32 parses the hexadecimal representation of an uint32 value
+//
+// Parameters:
+//
+//	data: A byte slice containing hex digits, optionally preceded by HexPrefix.
+//
+// Returns:
+//
+// The parsed uint32 value and an error code indicating success or failure.
+func ParseHex32(data []byte) (uint32, tinygoerrors.ErrorCode) {
+	value, err := parseHex(data, 32)
+	return uint32(value), err
+}
+
+// ParseHex64 parses the hexadecimal representation of an uint64 value
+//
+// Parameters:
+//
+//	data: A byte slice containing hex digits, optionally preceded by HexPrefix.
+//
+// Returns:
+//
+// The parsed uint64 value and an error code indicating success or failure.
+func ParseHex64(data []byte) (uint64, tinygoerrors.ErrorCode) {
+	return parseHex(data, 64)
+}
+
+// parseHex parses hex digits, optionally preceded by HexPrefix, into a value of the given bit size
+//
+// Parameters:
+//
+//	data: A byte slice containing hex digits.
+//	size: The size of the value in bits (8, 16, 32, or 64).
+//
+// Returns:
+//
+// The parsed value and an error code indicating success or failure.
+func parseHex(data []byte, size int) (uint64, tinygoerrors.ErrorCode) {
+	// Strip the optional prefix
+	if len(data) >= len(HexPrefix) && string(data[:len(HexPrefix)]) == string(HexPrefix) {
+		data = data[len(HexPrefix):]
+	}
+	if len(data) == 0 {
+		return 0, ErrorCodeBuffersEmptyInput
+	}
+
+	var value uint64
+	for _, c := range data {
+		digit := HexDigitValue(c)
+		if digit < 0 {
+			return 0, ErrorCodeBuffersInvalidDigit
+		}
+
+		// Check that shifting in another digit does not drop any set bit
+		if value>>(size-4) != 0 {
+			return 0, ErrorCodeBuffersValueOverflow
+		}
+		value = value<<4 | uint64(digit)
+	}
+	return value, tinygoerrors.ErrorCodeNil
+}
+
+// HexDigitValue returns the value of an ASCII hex digit
+//
+// Parameters:
+//
+//	c: The ASCII character, either uppercase or lowercase.
+//
+// Returns:
+//
+// The value of the hex digit, or -1 if the character is not a hex digit.
+func HexDigitValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'F':
+		return int(c-'A') + 10
+	case c >= 'a' && c <= 'f':
+		return int(c-'a') + 10
+	}
+	return -1
+}
+
+// ParseFloat64 parses the decimal representation of a float64 value, rounding to the nearest value half to even
+//
+// Parameters:
+//
+//	data: A byte slice containing an optional sign, an integer part, an optional fraction after a dot and an
+//	optional exponent after an 'e' or 'E', or one of "nan", "inf" and "infinity" in any case.
+//
+// Returns:
+//
+// The parsed float64 value and an error code indicating success or failure. If the value is too large, it returns
+// the infinity of the corresponding sign with ErrorCodeBuffersValueOverflow.
+func ParseFloat64(data []byte) (float64, tinygoerrors.ErrorCode) {
+	negative := false
+	if len(data) > 0 && (data[0] == '-' || data[0] == '+') {
+		negative = data[0] == '-'
+		data = data[1:]
+	}
+	if len(data) == 0 {
+		return 0, ErrorCodeBuffersEmptyInput
+	}
+
+	// Check for the special values
+	if value, ok := parseFloat64Special(data, negative); ok {
+		return value, tinygoerrors.ErrorCodeNil
+	}
+
+	mantissa, exp, truncated, err := readFloat64(data)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+
+	// Try the exact conversion, which only needs a single floating point operation
+	if !truncated {
+		if value, ok := float64Exact(mantissa, exp, negative); ok {
+			return value, tinygoerrors.ErrorCodeNil
+		}
+	}
+
+	// Fall back to the multiprecision conversion
+	var digits [decimalParseDigits + decimalShiftSlack]byte
+	d := decimal{d: digits[:]}
+	d.set(data, negative)
+	bits, overflow := d.float64Bits()
+	if overflow {
+		return math.Float64frombits(bits), ErrorCodeBuffersValueOverflow
+	}
+	return math.Float64frombits(bits), tinygoerrors.ErrorCodeNil
+}
+
+// parseFloat64Special parses the special float64 values, ignoring the case
+//
+// Parameters:
+//
+//	data: A byte slice without sign.
+//	negative: Whether the value is negative.
+//
+// Returns:
+//
+// The special value and true if the data matches "nan", "inf" or "infinity", or false otherwise.
+func parseFloat64Special(data []byte, negative bool) (float64, bool) {
+	switch {
+	case equalFoldASCII(data, "nan"):
+		return math.NaN(), true
+	case equalFoldASCII(data, "inf"), equalFoldASCII(data, "infinity"):
+		if negative {
+			return math.Inf(-1), true
+		}
+		return math.Inf(1), true
+	}
+	return 0, false
+}
+
+// equalFoldASCII reports whether the data is equal to a lowercase ASCII word, ignoring the case
+//
+// Parameters:
+//
+//	data: The byte slice to compare.
+//	word: The lowercase ASCII word.
+//
+// Returns:
+//
+// True if both are equal ignoring the case.
+func equalFoldASCII(data []byte, word string) bool {
+	if len(data) != len(word) {
+		return false
+	}
+	for i, c := range data {
+		if c|0x20 != word[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// readFloat64 validates an unsigned decimal number and reads its first 19 significant digits
+//
+// Parameters:
+//
+//	data: A byte slice without sign.
+//
+// Returns:
+//
+// The mantissa, the decimal exponent applied to it, whether nonzero digits were discarded, and an error code
+// indicating success or failure.
+func readFloat64(data []byte) (uint64, int, bool, tinygoerrors.ErrorCode) {
+	const maxMantissaDigits = 19
+	var mantissa uint64
+	truncated := false
+	sawDot, sawDigits := false, false
+	nd, ndMantissa, dp := 0, 0, 0
+
+	// Read the integer and fractional digits
+	i := 0
+	for ; i < len(data); i++ {
+		c := data[i]
+		if c == '.' {
+			if sawDot {
+				return 0, 0, false, ErrorCodeBuffersInvalidDigit
+			}
+			sawDot = true
+			dp = nd
+			continue
+		}
+		if c < '0' || c > '9' {
+			break
+		}
+		sawDigits = true
+
+		// Ignore leading zeros
+		if c == '0' && nd == 0 {
+			dp--
+			continue
+		}
+		nd++
+		if ndMantissa < maxMantissaDigits {
+			mantissa = mantissa*10 + uint64(c-'0')
+			ndMantissa++
+		} else if c != '0' {
+			truncated = true
+		}
+	}
+	if !sawDigits {
+		return 0, 0, false, ErrorCodeBuffersInvalidDigit
+	}
+	if !sawDot {
+		dp = nd
+	}
+
+	// Read the optional exponent
+	if i < len(data) {
+		if data[i] != 'e' && data[i] != 'E' {
+			return 0, 0, false, ErrorCodeBuffersInvalidDigit
+		}
+		i++
+		sign := 1
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			if data[i] == '-' {
+				sign = -1
+			}
+			i++
+		}
+		if i == len(data) {
+			return 0, 0, false, ErrorCodeBuffersInvalidDigit
+		}
+		exp := 0
+		for ; i < len(data); i++ {
+			if data[i] < '0' || data[i] > '9' {
+				return 0, 0, false, ErrorCodeBuffersInvalidDigit
+			}
+			if exp < 10000 {
+				exp = exp*10 + int(data[i]-'0')
+			}
+		}
+		dp += exp * sign
+	}
+
+	if mantissa == 0 {
+		return 0, 0, truncated, tinygoerrors.ErrorCodeNil
+	}
+	return mantissa, dp - ndMantissa, truncated, tinygoerrors.ErrorCodeNil
+}
+
+// float64Exact converts a mantissa and a decimal exponent to float64 when both are exactly representable, so a
+// single floating point operation gives the correctly rounded result
+//
+// Parameters:
+//
+//	mantissa: The decimal mantissa.
+//	exp: The decimal exponent applied to the mantissa.
+//	negative: Whether the value is negative.
+//
+// Returns:
+//
+// The float64 value and true if the conversion was exact, or false otherwise.
+func float64Exact(mantissa uint64, exp int, negative bool) (float64, bool) {
+	if mantissa>>52 != 0 {
+		return 0, false
+	}
+	value := float64(mantissa)
+	if negative {
+		value = -value
+	}
+
+	switch {
+	case exp == 0:
+		return value, true
+	case exp > 0 && exp <= 15+22:
+		// Move the excess of the exponent into the mantissa while it stays exact
+		if exp > 22 {
+			value *= float64Pow10[exp-22]
+			exp = 22
+		}
+		if value > 1e15 || value < -1e15 {
+			return 0, false
+		}
+		return value * float64Pow10[exp], true
+	case exp < 0 && exp >= -22:
+		return value / float64Pow10[-exp], true
+	}
+	return 0, false
+}
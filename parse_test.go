@@ -0,0 +1,336 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// parseFloat64Tests are inputs at the rounding, subnormal and overflow edges of ParseFloat64, and its syntax errors
+var parseFloat64Tests = []struct {
+	input string
+	want  float64
+	err   tinygoerrors.ErrorCode
+}{
+	{"0", 0, tinygoerrors.ErrorCodeNil},
+	{"-0", math.Copysign(0, -1), tinygoerrors.ErrorCodeNil},
+	{"+1.5", 1.5, tinygoerrors.ErrorCodeNil},
+	{"+.5e1", 5, tinygoerrors.ErrorCodeNil},
+	{"5.", 5, tinygoerrors.ErrorCodeNil},
+	{"0.1", 0.1, tinygoerrors.ErrorCodeNil},
+	{"1E+2", 100, tinygoerrors.ErrorCodeNil},
+	{"123456789012345678901234567890", 1.2345678901234568e29, tinygoerrors.ErrorCodeNil},
+	{"Infinity", math.Inf(1), tinygoerrors.ErrorCodeNil},
+	{"-inf", math.Inf(-1), tinygoerrors.ErrorCodeNil},
+
+	// Halfway between two float64 values, which round to the even mantissa unless a later digit breaks the tie
+	{"9007199254740993", 9007199254740992, tinygoerrors.ErrorCodeNil},
+	{"9007199254740995", 9007199254740996, tinygoerrors.ErrorCodeNil},
+	{"9007199254740993.000000000000000000001", 9007199254740994, tinygoerrors.ErrorCodeNil},
+	{"1.00000000000000011102230246251565404236316680908203125", 1, tinygoerrors.ErrorCodeNil},
+	{"1.00000000000000011102230246251565404236316680908203126", 1.0000000000000002, tinygoerrors.ErrorCodeNil},
+
+	// Subnormals and underflow
+	{"5e-324", 5e-324, tinygoerrors.ErrorCodeNil},
+	{"2.4703282292062327e-324", 0, tinygoerrors.ErrorCodeNil},
+	{"2.4703282292062328e-324", 5e-324, tinygoerrors.ErrorCodeNil},
+	{"2.2250738585072011e-308", 2.225073858507201e-308, tinygoerrors.ErrorCodeNil},
+	{"2.2250738585072014e-308", 2.2250738585072014e-308, tinygoerrors.ErrorCodeNil},
+	{"1e-400", 0, tinygoerrors.ErrorCodeNil},
+	{"-1e-400", math.Copysign(0, -1), tinygoerrors.ErrorCodeNil},
+
+	// Overflow to infinity
+	{"1.7976931348623157e308", math.MaxFloat64, tinygoerrors.ErrorCodeNil},
+	{"1.7976931348623158e308", math.MaxFloat64, tinygoerrors.ErrorCodeNil},
+	{"1.7976931348623159e308", math.Inf(1), ErrorCodeBuffersValueOverflow},
+	{"1e309", math.Inf(1), ErrorCodeBuffersValueOverflow},
+	{"-1e309", math.Inf(-1), ErrorCodeBuffersValueOverflow},
+	{"1e100000", math.Inf(1), ErrorCodeBuffersValueOverflow},
+
+	// Syntax errors
+	{"", 0, ErrorCodeBuffersEmptyInput},
+	{"+", 0, ErrorCodeBuffersEmptyInput},
+	{"-", 0, ErrorCodeBuffersEmptyInput},
+	{".", 0, ErrorCodeBuffersInvalidDigit},
+	{"e5", 0, ErrorCodeBuffersInvalidDigit},
+	{"1e", 0, ErrorCodeBuffersInvalidDigit},
+	{"1e+", 0, ErrorCodeBuffersInvalidDigit},
+	{"1e5x", 0, ErrorCodeBuffersInvalidDigit},
+	{"1.2.3", 0, ErrorCodeBuffersInvalidDigit},
+	{"--1", 0, ErrorCodeBuffersInvalidDigit},
+	{"1 ", 0, ErrorCodeBuffersInvalidDigit},
+	{"0x10", 0, ErrorCodeBuffersInvalidDigit},
+	{"infinit", 0, ErrorCodeBuffersInvalidDigit},
+}
+
+// checkParseFloat64 compares the bits and the error code returned by ParseFloat64
+func checkParseFloat64(t *testing.T, input string, want float64, wantErr tinygoerrors.ErrorCode) {
+	t.Helper()
+	got, err := ParseFloat64([]byte(input))
+	if err != wantErr {
+		t.Errorf("ParseFloat64(%.40q) returned error %d, want %d", input, err, wantErr)
+		return
+	}
+	if err == tinygoerrors.ErrorCodeNil || err == ErrorCodeBuffersValueOverflow {
+		if math.Float64bits(got) != math.Float64bits(want) {
+			t.Errorf("ParseFloat64(%.40q) = %b, want %b", input, got, want)
+		}
+	}
+}
+
+// TestParseFloat64 checks the table cases and NaN, whose bits are not compared
+func TestParseFloat64(t *testing.T) {
+	for _, test := range parseFloat64Tests {
+		checkParseFloat64(t, test.input, test.want, test.err)
+	}
+	for _, input := range []string{"nan", "NaN", "-NAN"} {
+		if got, err := ParseFloat64([]byte(input)); err != tinygoerrors.ErrorCodeNil || !math.IsNaN(got) {
+			t.Errorf("ParseFloat64(%q) = %v, %d, want NaN", input, got, err)
+		}
+	}
+}
+
+// TestParseFloat64LongInput checks inputs with more digits than decimalParseDigits, whose digits beyond it must still
+// break a tie
+func TestParseFloat64LongInput(t *testing.T) {
+	// The exact decimal expansion of 2^-1075, halfway between zero and the smallest subnormal
+	half := new(big.Float).SetMantExp(big.NewFloat(1), -1075).Text('f', 1075)
+	half = strings.TrimRight(half, "0")
+	padding := strings.Repeat("0", decimalParseDigits)
+	checkParseFloat64(t, half, 0, tinygoerrors.ErrorCodeNil)
+	checkParseFloat64(t, half+padding, 0, tinygoerrors.ErrorCodeNil)
+	checkParseFloat64(t, half+padding+"1", 5e-324, tinygoerrors.ErrorCodeNil)
+	checkParseFloat64(t, "-"+half+padding+"1", -5e-324, tinygoerrors.ErrorCodeNil)
+
+	// Halfway between 1 and its successor, with the tie broken after the kept digits
+	tie := "1.00000000000000011102230246251565404236316680908203125"
+	checkParseFloat64(t, tie+padding, 1, tinygoerrors.ErrorCodeNil)
+	checkParseFloat64(t, tie+padding+"1", 1.0000000000000002, tinygoerrors.ErrorCodeNil)
+
+	// Long runs of digits scaled back by the exponent
+	ones := strings.Repeat("1", 2*decimalParseDigits)
+	checkParseFloat64(t, ones+"e-1599", 1.1111111111111112, tinygoerrors.ErrorCodeNil)
+	checkParseFloat64(t, "1"+padding+"e-800", 1, tinygoerrors.ErrorCodeNil)
+	checkParseFloat64(t, "0."+padding+"1e801", 1, tinygoerrors.ErrorCodeNil)
+}
+
+// FuzzParseFloat64 compares ParseFloat64 bit for bit with strconv.ParseFloat
+func FuzzParseFloat64(f *testing.F) {
+	for _, test := range parseFloat64Tests {
+		f.Add(test.input)
+	}
+	f.Add("1.00000000000000011102230246251565404236316680908203125")
+	f.Add("4.9406564584124654e-324")
+	f.Add("0.000000000000000000000000000000001e33")
+	f.Fuzz(func(t *testing.T, input string) {
+		got, err := ParseFloat64([]byte(input))
+		want, wantErr := strconv.ParseFloat(input, 64)
+
+		// Hexadecimal mantissas and underscores are not supported, while strconv accepts them after a base prefix and
+		// may report a range error before rejecting a misplaced underscore
+		if strings.ContainsAny(input, "xX_") {
+			if err != ErrorCodeBuffersEmptyInput && err != ErrorCodeBuffersInvalidDigit {
+				t.Fatalf("ParseFloat64(%q) = %b, %d, want a syntax error", input, got, err)
+			}
+			return
+		}
+
+		// A sign is accepted before "nan", which strconv rejects
+		if err == tinygoerrors.ErrorCodeNil && math.IsNaN(got) {
+			if value, _ := strconv.ParseFloat(strings.TrimLeft(input, "+-"), 64); !math.IsNaN(value) {
+				t.Fatalf("ParseFloat64(%q) = NaN, strconv = %v, %v", input, want, wantErr)
+			}
+			return
+		}
+
+		switch {
+		case wantErr == nil:
+			if err != tinygoerrors.ErrorCodeNil || math.Float64bits(got) != math.Float64bits(want) {
+				t.Fatalf("ParseFloat64(%q) = %b, %d, want %b", input, got, err, want)
+			}
+		case wantErr.(*strconv.NumError).Err == strconv.ErrRange:
+			if err != ErrorCodeBuffersValueOverflow || math.Float64bits(got) != math.Float64bits(want) {
+				t.Fatalf("ParseFloat64(%q) = %b, %d, want %b with ValueOverflow", input, got, err, want)
+			}
+		default:
+			if err != ErrorCodeBuffersEmptyInput && err != ErrorCodeBuffersInvalidDigit {
+				t.Fatalf("ParseFloat64(%q) = %b, %d, want a syntax error", input, got, err)
+			}
+		}
+	})
+}
+
+// TestParseUintDecimal checks the uint64 boundaries, the error paths and a round trip through AppendUintDecimal
+func TestParseUintDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  uint64
+		err   tinygoerrors.ErrorCode
+	}{
+		{"0", 0, tinygoerrors.ErrorCodeNil},
+		{"42", 42, tinygoerrors.ErrorCodeNil},
+		{"000000000000000000000000000042", 42, tinygoerrors.ErrorCodeNil},
+		{"18446744073709551615", math.MaxUint64, tinygoerrors.ErrorCodeNil},
+		{"18446744073709551616", 0, ErrorCodeBuffersValueOverflow},
+		{"18446744073709551620", 0, ErrorCodeBuffersValueOverflow},
+		{"99999999999999999999", 0, ErrorCodeBuffersValueOverflow},
+		{"", 0, ErrorCodeBuffersEmptyInput},
+		{"+1", 0, ErrorCodeBuffersInvalidDigit},
+		{"-0", 0, ErrorCodeBuffersInvalidDigit},
+		{"12a", 0, ErrorCodeBuffersInvalidDigit},
+	}
+	for _, test := range tests {
+		if got, err := ParseUintDecimal([]byte(test.input)); got != test.want || err != test.err {
+			t.Errorf("ParseUintDecimal(%q) = %d, %d, want %d, %d", test.input, got, err, test.want, test.err)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		value := rng.Uint64() >> rng.Intn(64)
+		if got, err := ParseUintDecimal(AppendUintDecimal(nil, value)); got != value || err != tinygoerrors.ErrorCodeNil {
+			t.Fatalf("ParseUintDecimal(AppendUintDecimal(%d)) = %d, %d", value, got, err)
+		}
+	}
+}
+
+// TestParseIntDecimal checks the int64 boundaries, the signs, the error paths and a round trip through
+// AppendIntDecimal
+func TestParseIntDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+		err   tinygoerrors.ErrorCode
+	}{
+		{"0", 0, tinygoerrors.ErrorCodeNil},
+		{"-0", 0, tinygoerrors.ErrorCodeNil},
+		{"+0", 0, tinygoerrors.ErrorCodeNil},
+		{"+42", 42, tinygoerrors.ErrorCodeNil},
+		{"-42", -42, tinygoerrors.ErrorCodeNil},
+		{"9223372036854775807", math.MaxInt64, tinygoerrors.ErrorCodeNil},
+		{"9223372036854775808", 0, ErrorCodeBuffersValueOverflow},
+		{"-9223372036854775808", math.MinInt64, tinygoerrors.ErrorCodeNil},
+		{"-9223372036854775809", 0, ErrorCodeBuffersValueOverflow},
+		{"18446744073709551616", 0, ErrorCodeBuffersValueOverflow},
+		{"", 0, ErrorCodeBuffersEmptyInput},
+		{"-", 0, ErrorCodeBuffersEmptyInput},
+		{"+", 0, ErrorCodeBuffersEmptyInput},
+		{"--1", 0, ErrorCodeBuffersInvalidDigit},
+		{"1-", 0, ErrorCodeBuffersInvalidDigit},
+	}
+	for _, test := range tests {
+		if got, err := ParseIntDecimal([]byte(test.input)); got != test.want || err != test.err {
+			t.Errorf("ParseIntDecimal(%q) = %d, %d, want %d, %d", test.input, got, err, test.want, test.err)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		value := int64(rng.Uint64()) >> rng.Intn(64)
+		if got, err := ParseIntDecimal(AppendIntDecimal(nil, value)); got != value || err != tinygoerrors.ErrorCodeNil {
+			t.Fatalf("ParseIntDecimal(AppendIntDecimal(%d)) = %d, %d", value, got, err)
+		}
+	}
+}
+
+// parseHexFuncs adapts ParseHex8 to ParseHex64 and AppendHex8 to AppendHex64 to the uint64 type, by bit size
+var parseHexFuncs = []struct {
+	size   int
+	parse  func([]byte) (uint64, tinygoerrors.ErrorCode)
+	append func([]byte, uint64) []byte
+}{
+	{
+		8,
+		func(data []byte) (uint64, tinygoerrors.ErrorCode) {
+			value, err := ParseHex8(data)
+			return uint64(value), err
+		},
+		func(dst []byte, value uint64) []byte { return AppendHex8(dst, uint8(value)) },
+	},
+	{
+		16,
+		func(data []byte) (uint64, tinygoerrors.ErrorCode) {
+			value, err := ParseHex16(data)
+			return uint64(value), err
+		},
+		func(dst []byte, value uint64) []byte { return AppendHex16(dst, uint16(value)) },
+	},
+	{
+		32,
+		func(data []byte) (uint64, tinygoerrors.ErrorCode) {
+			value, err := ParseHex32(data)
+			return uint64(value), err
+		},
+		func(dst []byte, value uint64) []byte { return AppendHex32(dst, uint32(value)) },
+	},
+	{64, ParseHex64, AppendHex64},
+}
+
+// TestParseHex checks every width at its maximum, one digit past it, with mixed case digits, the prefix and the error
+// paths, and a round trip through the AppendHex functions
+func TestParseHex(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, funcs := range parseHexFuncs {
+		max := uint64(1)<<funcs.size - 1
+		if funcs.size == 64 {
+			max = math.MaxUint64
+		}
+		digits := strings.Repeat("F", funcs.size/4)
+		tests := []struct {
+			input string
+			want  uint64
+			err   tinygoerrors.ErrorCode
+		}{
+			{"0", 0, tinygoerrors.ErrorCodeNil},
+			{"aB", 0xAB, tinygoerrors.ErrorCodeNil},
+			{"0xfA", 0xFA, tinygoerrors.ErrorCodeNil},
+			{digits, max, tinygoerrors.ErrorCodeNil},
+			{strings.ToLower(digits), max, tinygoerrors.ErrorCodeNil},
+			{"0x" + digits, max, tinygoerrors.ErrorCodeNil},
+			{"0000" + digits, max, tinygoerrors.ErrorCodeNil},
+			{"1" + strings.Repeat("0", funcs.size/4), 0, ErrorCodeBuffersValueOverflow},
+			{digits + "F", 0, ErrorCodeBuffersValueOverflow},
+			{"", 0, ErrorCodeBuffersEmptyInput},
+			{"0x", 0, ErrorCodeBuffersEmptyInput},
+			{"0X1", 0, ErrorCodeBuffersInvalidDigit},
+			{"1g", 0, ErrorCodeBuffersInvalidDigit},
+			{"-1", 0, ErrorCodeBuffersInvalidDigit},
+		}
+		for _, test := range tests {
+			if got, err := funcs.parse([]byte(test.input)); got != test.want || err != test.err {
+				t.Errorf(
+					"ParseHex%d(%q) = %#x, %d, want %#x, %d", funcs.size, test.input, got, err, test.want, test.err,
+				)
+			}
+		}
+
+		for i := 0; i < 10000; i++ {
+			value := rng.Uint64() >> rng.Intn(64) & max
+			if got, err := funcs.parse(funcs.append(nil, value)); got != value || err != tinygoerrors.ErrorCodeNil {
+				t.Fatalf("ParseHex%d(AppendHex%d(%#x)) = %#x, %d", funcs.size, funcs.size, value, got, err)
+			}
+			formatted := AppendHex64Formatted(nil, value, FormatLowercase|FormatPrefix|FormatTrimLeadingZeros)
+			if got, err := funcs.parse(formatted); got != value || err != tinygoerrors.ErrorCodeNil {
+				t.Fatalf("ParseHex%d(%q) = %#x, %d, want %#x", funcs.size, formatted, got, err, value)
+			}
+		}
+	}
+}
+
+// TestHexDigitValue checks every byte value against strconv
+func TestHexDigitValue(t *testing.T) {
+	for c := 0; c <= math.MaxUint8; c++ {
+		want := -1
+		if value, err := strconv.ParseUint(string(rune(c)), 16, 8); err == nil {
+			want = int(value)
+		}
+		if got := HexDigitValue(byte(c)); got != want {
+			t.Errorf("HexDigitValue(%q) = %d, want %d", c, got, want)
+		}
+	}
+}
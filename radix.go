@@ -0,0 +1,618 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/bits"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+const (
+	// radixMinBase is the smallest base supported by the radix formatters
+	radixMinBase = 2
+
+	// radixMaxBase is the largest base supported by the radix formatters
+	radixMaxBase = 36
+
+	// radixMaxDigits is the number of digits of the largest uint64 in base 2
+	radixMaxDigits = 64
+
+	// radixGroupSize is the number of digits in each group separated by FormatGroupDigits
+	radixGroupSize = 4
+)
+
+// AppendBinary8 appends the binary representation of an uint8 value to the given byte slice, like "0b0010_1100"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply. Without
+//	FormatTrimLeadingZeros the output has 8 digits.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendBinary8(dst []byte, value uint8, flags FormatFlags) []byte {
+	return appendRadix(dst, uint64(value), 2, radixWidth(8, 1, flags), flags)
+}
+
+// AppendBinary16 appends the binary representation of an uint16 value to the given byte slice, like "0b0010_1100"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply. Without
+//	FormatTrimLeadingZeros the output has 16 digits.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendBinary16(dst []byte, value uint16, flags FormatFlags) []byte {
+	return appendRadix(dst, uint64(value), 2, radixWidth(16, 1, flags), flags)
+}
+
+// AppendBinary32 appends the binary representation of an uint32 value to the given byte slice, like "0b0010_1100"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply. Without
+//	FormatTrimLeadingZeros the output has 32 digits.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendBinary32(dst []byte, value uint32, flags FormatFlags) []byte {
+	return appendRadix(dst, uint64(value), 2, radixWidth(32, 1, flags), flags)
+}
+
+// AppendBinary64 appends the binary representation of an uint64 value to the given byte slice, like "0b0010_1100"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply. Without
+//	FormatTrimLeadingZeros the output has 64 digits.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendBinary64(dst []byte, value uint64, flags FormatFlags) []byte {
+	return appendRadix(dst, uint64(value), 2, radixWidth(64, 1, flags), flags)
+}
+
+// AppendOctal8 appends the octal representation of an uint8 value to the given byte slice, like "0o377"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply. Without
+//	FormatTrimLeadingZeros the output has 3 digits.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendOctal8(dst []byte, value uint8, flags FormatFlags) []byte {
+	return appendRadix(dst, uint64(value), 8, radixWidth(8, 3, flags), flags)
+}
+
+// AppendOctal16 appends the octal representation of an uint16 value to the given byte slice, like "0o377"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply. Without
+//	FormatTrimLeadingZeros the output has 6 digits.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendOctal16(dst []byte, value uint16, flags FormatFlags) []byte {
+	return appendRadix(dst, uint64(value), 8, radixWidth(16, 3, flags), flags)
+}
+
+// AppendOctal32 appends the octal representation of an uint32 value to the given byte slice, like "0o377"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply. Without
+//	FormatTrimLeadingZeros the output has 11 digits.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendOctal32(dst []byte, value uint32, flags FormatFlags) []byte {
+	return appendRadix(dst, uint64(value), 8, radixWidth(32, 3, flags), flags)
+}
+
+// AppendOctal64 appends the octal representation of an uint64 value to the given byte slice, like "0o377"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply. Without
+//	FormatTrimLeadingZeros the output has 22 digits.
+//
+// Returns:
+//
+// The extended byte slice. No allocation is made if dst has enough capacity.
+func AppendOctal64(dst []byte, value uint64, flags FormatFlags) []byte {
+	return appendRadix(dst, uint64(value), 8, radixWidth(64, 3, flags), flags)
+}
+
+// AppendUintRadix appends the minimal representation of an uint64 value in the given base to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint64 value to convert.
+//	base: The base, from 2 to 36. The digits above 9 are letters from ASCIIRadixDigits.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatGroupDigits to apply. The prefix is only written
+//	for the bases 2, 8 and 16.
+//
+// Returns:
+//
+// The extended byte slice, or dst and an error if the base is not supported. No allocation is made if dst has enough
+// capacity.
+func AppendUintRadix(dst []byte, value uint64, base int, flags FormatFlags) ([]byte, tinygoerrors.ErrorCode) {
+	return AppendUintRadixFixed(dst, value, base, 1, flags)
+}
+
+// AppendUintRadixFixed appends the representation of an uint64 value in the given base with fixed width to the given
+// byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The uint64 value to convert.
+//	base: The base, from 2 to 36. The digits above 9 are letters from ASCIIRadixDigits.
+//	width: The minimum number of digits, up to 64, padded with leading zeros.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatGroupDigits to apply. The prefix is only written
+//	for the bases 2, 8 and 16.
+//
+// Returns:
+//
+// The extended byte slice, or dst and an error if the base is not supported. No allocation is made if dst has enough
+// capacity.
+func AppendUintRadixFixed(dst []byte, value uint64, base int, width int, flags FormatFlags) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	if base < radixMinBase || base > radixMaxBase {
+		return dst, ErrorCodeBuffersInvalidBase
+	}
+	return appendRadix(dst, value, base, width, flags), tinygoerrors.ErrorCodeNil
+}
+
+// radixWidth returns the number of digits of a fixed-width representation
+//
+// Parameters:
+//
+//	size: The size of the value in bits.
+//	digitBits: The number of bits of each digit, 1 for binary and 3 for octal.
+//	flags: The format flags, FormatTrimLeadingZeros selects the minimal width.
+//
+// Returns:
+//
+// The number of digits needed for every value of the given size, or 1 if leading zeros are trimmed.
+func radixWidth(size int, digitBits int, flags FormatFlags) int {
+	if flags&FormatTrimLeadingZeros != 0 {
+		return 1
+	}
+	return (size + digitBits - 1) / digitBits
+}
+
+// appendRadix appends the representation of a value in a supported base
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The value to convert.
+//	base: The base, from 2 to 36.
+//	width: The minimum number of digits, padded with leading zeros.
+//	flags: The format flags to apply.
+//
+// Returns:
+//
+// The extended byte slice.
+func appendRadix(dst []byte, value uint64, base int, width int, flags FormatFlags) []byte {
+	if flags&FormatPrefix != 0 {
+		switch base {
+		case 2:
+			dst = append(dst, BinaryPrefix...)
+		case 8:
+			dst = append(dst, OctalPrefix...)
+		case 16:
+			dst = append(dst, HexPrefix...)
+		}
+	}
+	digits := ASCIIRadixDigits
+	if flags&FormatLowercase != 0 {
+		digits = ASCIILowerRadixDigits
+	}
+
+	// Fill the scratch buffer from the end, using shifts for the powers of two
+	var buffer [radixMaxDigits]byte
+	i := len(buffer)
+	if base&(base-1) == 0 {
+		shift := uint(bits.TrailingZeros(uint(base)))
+		mask := uint64(base - 1)
+		for value != 0 {
+			i--
+			buffer[i] = digits[value&mask]
+			value >>= shift
+		}
+	} else {
+		// Split off chunks of digits with a single 64-bit division each, so the digits are divided in 32 bits
+		chunk, chunkDigits := radixChunk(uint32(base))
+		for value > math.MaxUint32 {
+			quotient := value / uint64(chunk)
+			i = putUint32Radix(buffer[:i], uint32(value-quotient*uint64(chunk)), uint32(base), chunkDigits, digits)
+			value = quotient
+		}
+		i = putUint32Radix(buffer[:i], uint32(value), uint32(base), 0, digits)
+	}
+
+	// Pad with leading zeros, keeping at least one digit
+	width = max(1, min(width, len(buffer)))
+	for len(buffer)-i < width {
+		i--
+		buffer[i] = ASCIIDecimalDigits[0]
+	}
+
+	// Write the digits, separating the groups counted from the least significant digit
+	count := len(buffer) - i
+	for j, digit := range buffer[i:] {
+		if flags&FormatGroupDigits != 0 && j > 0 && (count-j)%radixGroupSize == 0 {
+			dst = append(dst, '_')
+		}
+		dst = append(dst, digit)
+	}
+	return dst
+}
+
+// radixChunk returns the largest power of a base that fits in an uint32
+//
+// Parameters:
+//
+//	base: The base, from 2 to 36.
+//
+// Returns:
+//
+// The power of the base and its exponent, which is the number of digits of each chunk.
+func radixChunk(base uint32) (uint32, int) {
+	chunk, chunkDigits := base, 1
+	for chunk <= math.MaxUint32/base {
+		chunk *= base
+		chunkDigits++
+	}
+	return chunk, chunkDigits
+}
+
+// putUint32Radix writes the digits of an uint32 value in the given base at the end of the given buffer
+//
+// Parameters:
+//
+//	buffer: The buffer to write to, which must have room for the digits and the padding.
+//	value: The uint32 value to convert.
+//	base: The base, from 2 to 36.
+//	width: The minimum number of digits, padded with leading zeros. With a zero width, no digit is written for zero.
+//	digits: The digit characters, ASCIIRadixDigits or ASCIILowerRadixDigits.
+//
+// Returns:
+//
+// The index of the first written digit.
+func putUint32Radix(buffer []byte, value uint32, base uint32, width int, digits []byte) int {
+	i := len(buffer)
+	for value != 0 {
+		quotient := value / base
+		i--
+		buffer[i] = digits[value-quotient*base]
+		value = quotient
+	}
+	for len(buffer)-i < width {
+		i--
+		buffer[i] = ASCIIDecimalDigits[0]
+	}
+	return i
+}
+
+// Uint8ToBinary converts an uint8 value to its binary representation
+//
+// Parameters:
+//
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the binary representation of the uint8 value.
+func (f *Formatter) Uint8ToBinary(value uint8, flags FormatFlags) []byte {
+	return AppendBinary8(f.uintToRadixBuffer[:0], value, flags)
+}
+
+// Uint16ToBinary converts an uint16 value to its binary representation
+//
+// Parameters:
+//
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the binary representation of the uint16 value.
+func (f *Formatter) Uint16ToBinary(value uint16, flags FormatFlags) []byte {
+	return AppendBinary16(f.uintToRadixBuffer[:0], value, flags)
+}
+
+// Uint32ToBinary converts an uint32 value to its binary representation
+//
+// Parameters:
+//
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the binary representation of the uint32 value.
+func (f *Formatter) Uint32ToBinary(value uint32, flags FormatFlags) []byte {
+	return AppendBinary32(f.uintToRadixBuffer[:0], value, flags)
+}
+
+// Uint64ToBinary converts an uint64 value to its binary representation
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the binary representation of the uint64 value.
+func (f *Formatter) Uint64ToBinary(value uint64, flags FormatFlags) []byte {
+	return AppendBinary64(f.uintToRadixBuffer[:0], value, flags)
+}
+
+// Uint8ToOctal converts an uint8 value to its octal representation
+//
+// Parameters:
+//
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the octal representation of the uint8 value.
+func (f *Formatter) Uint8ToOctal(value uint8, flags FormatFlags) []byte {
+	return AppendOctal8(f.uintToRadixBuffer[:0], value, flags)
+}
+
+// Uint16ToOctal converts an uint16 value to its octal representation
+//
+// Parameters:
+//
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the octal representation of the uint16 value.
+func (f *Formatter) Uint16ToOctal(value uint16, flags FormatFlags) []byte {
+	return AppendOctal16(f.uintToRadixBuffer[:0], value, flags)
+}
+
+// Uint32ToOctal converts an uint32 value to its octal representation
+//
+// Parameters:
+//
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the octal representation of the uint32 value.
+func (f *Formatter) Uint32ToOctal(value uint32, flags FormatFlags) []byte {
+	return AppendOctal32(f.uintToRadixBuffer[:0], value, flags)
+}
+
+// Uint64ToOctal converts an uint64 value to its octal representation
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the octal representation of the uint64 value.
+func (f *Formatter) Uint64ToOctal(value uint64, flags FormatFlags) []byte {
+	return AppendOctal64(f.uintToRadixBuffer[:0], value, flags)
+}
+
+// UintToRadix converts an uint64 value to its minimal representation in the given base
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	base: The base, from 2 to 36.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the value in the given base, or an error if the base is not supported.
+func (f *Formatter) UintToRadix(value uint64, base int, flags FormatFlags) ([]byte, tinygoerrors.ErrorCode) {
+	return AppendUintRadix(f.uintToRadixBuffer[:0], value, base, flags)
+}
+
+// UintToRadixFixed converts an uint64 value to its representation in the given base with fixed width
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	base: The base, from 2 to 36.
+//	width: The minimum number of digits, up to 64.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the value in the given base, with leading zeros if necessary, or an error if the base is
+// not supported.
+func (f *Formatter) UintToRadixFixed(value uint64, base int, width int, flags FormatFlags) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	return AppendUintRadixFixed(f.uintToRadixBuffer[:0], value, base, width, flags)
+}
+
+// Uint8ToBinary converts an uint8 value to its binary representation
+//
+// Parameters:
+//
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the binary representation of the uint8 value.
+func Uint8ToBinary(value uint8, flags FormatFlags) []byte {
+	return defaultFormatter.Uint8ToBinary(value, flags)
+}
+
+// Uint16ToBinary converts an uint16 value to its binary representation
+//
+// Parameters:
+//
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the binary representation of the uint16 value.
+func Uint16ToBinary(value uint16, flags FormatFlags) []byte {
+	return defaultFormatter.Uint16ToBinary(value, flags)
+}
+
+// Uint32ToBinary converts an uint32 value to its binary representation
+//
+// Parameters:
+//
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the binary representation of the uint32 value.
+func Uint32ToBinary(value uint32, flags FormatFlags) []byte {
+	return defaultFormatter.Uint32ToBinary(value, flags)
+}
+
+// Uint64ToBinary converts an uint64 value to its binary representation
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the binary representation of the uint64 value.
+func Uint64ToBinary(value uint64, flags FormatFlags) []byte {
+	return defaultFormatter.Uint64ToBinary(value, flags)
+}
+
+// Uint8ToOctal converts an uint8 value to its octal representation
+//
+// Parameters:
+//
+//	value: The uint8 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the octal representation of the uint8 value.
+func Uint8ToOctal(value uint8, flags FormatFlags) []byte {
+	return defaultFormatter.Uint8ToOctal(value, flags)
+}
+
+// Uint16ToOctal converts an uint16 value to its octal representation
+//
+// Parameters:
+//
+//	value: The uint16 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the octal representation of the uint16 value.
+func Uint16ToOctal(value uint16, flags FormatFlags) []byte {
+	return defaultFormatter.Uint16ToOctal(value, flags)
+}
+
+// Uint32ToOctal converts an uint32 value to its octal representation
+//
+// Parameters:
+//
+//	value: The uint32 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the octal representation of the uint32 value.
+func Uint32ToOctal(value uint32, flags FormatFlags) []byte {
+	return defaultFormatter.Uint32ToOctal(value, flags)
+}
+
+// Uint64ToOctal converts an uint64 value to its octal representation
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	flags: The combination of FormatPrefix, FormatTrimLeadingZeros and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the octal representation of the uint64 value.
+func Uint64ToOctal(value uint64, flags FormatFlags) []byte {
+	return defaultFormatter.Uint64ToOctal(value, flags)
+}
+
+// UintToRadix converts an uint64 value to its minimal representation in the given base
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	base: The base, from 2 to 36.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the value in the given base, or an error if the base is not supported.
+func UintToRadix(value uint64, base int, flags FormatFlags) ([]byte, tinygoerrors.ErrorCode) {
+	return defaultFormatter.UintToRadix(value, base, flags)
+}
+
+// UintToRadixFixed converts an uint64 value to its representation in the given base with fixed width
+//
+// Parameters:
+//
+//	value: The uint64 value to convert.
+//	base: The base, from 2 to 36.
+//	width: The minimum number of digits, up to 64.
+//	flags: The combination of FormatLowercase, FormatPrefix and FormatGroupDigits to apply.
+//
+// Returns:
+//
+// A byte slice representing the value in the given base, with leading zeros if necessary, or an error if the base is
+// not supported.
+func UintToRadixFixed(value uint64, base int, width int, flags FormatFlags) ([]byte, tinygoerrors.ErrorCode) {
+	return defaultFormatter.UintToRadixFixed(value, base, width, flags)
+}
@@ -0,0 +1,98 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// radixTestValues returns values around the chunk boundaries of a base, the 32-bit limit and the uint64 limits, and
+// random values of every magnitude
+func radixTestValues(base int) []uint64 {
+	values := []uint64{0, 1, uint64(base) - 1, uint64(base), math.MaxUint32, math.MaxUint32 + 1, math.MaxUint64}
+	chunk, _ := radixChunk(uint32(base))
+	for _, pow := range []uint64{uint64(chunk), uint64(chunk) * uint64(chunk)} {
+		values = append(values, pow-1, pow, pow+1)
+	}
+	rng := rand.New(rand.NewSource(int64(base)))
+	for i := 0; i < 2000; i++ {
+		values = append(values, rng.Uint64()>>rng.Intn(64))
+	}
+	return values
+}
+
+// TestAppendUintRadix compares every base with strconv, in both cases and with a fixed width
+func TestAppendUintRadix(t *testing.T) {
+	for base := radixMinBase; base <= radixMaxBase; base++ {
+		for _, value := range radixTestValues(base) {
+			want := strings.ToUpper(strconv.FormatUint(value, base))
+			got, err := AppendUintRadix(nil, value, base, 0)
+			if err != tinygoerrors.ErrorCodeNil || string(got) != want {
+				t.Fatalf("AppendUintRadix(%d, %d) = %q, %d, want %q", value, base, got, err, want)
+			}
+			got, _ = AppendUintRadix(nil, value, base, FormatLowercase)
+			if want := strings.ToLower(want); string(got) != want {
+				t.Fatalf("AppendUintRadix(%d, %d, FormatLowercase) = %q, want %q", value, base, got, want)
+			}
+			got, _ = AppendUintRadixFixed(nil, value, base, radixMaxDigits, 0)
+			if want := strings.Repeat("0", radixMaxDigits-len(want)) + want; string(got) != want {
+				t.Fatalf("AppendUintRadixFixed(%d, %d, %d) = %q, want %q", value, base, radixMaxDigits, got, want)
+			}
+		}
+	}
+}
+
+// TestAppendUintRadixPrefixed checks the bases with a prefix against strconv, and the binary and octal formatters
+func TestAppendUintRadixPrefixed(t *testing.T) {
+	for _, value := range radixTestValues(2) {
+		for _, test := range []struct {
+			base   int
+			prefix string
+		}{{2, "0b"}, {8, "0o"}, {10, ""}, {16, "0x"}, {36, ""}} {
+			want := test.prefix + strings.ToUpper(strconv.FormatUint(value, test.base))
+			if got, _ := AppendUintRadix(nil, value, test.base, FormatPrefix); string(got) != want {
+				t.Fatalf("AppendUintRadix(%d, %d, FormatPrefix) = %q, want %q", value, test.base, got, want)
+			}
+		}
+		binary := string(AppendBinary64(nil, value, FormatTrimLeadingZeros))
+		if want := strconv.FormatUint(value, 2); binary != want {
+			t.Fatalf("AppendBinary64(%d) = %q, want %q", value, binary, want)
+		}
+		octal := string(AppendOctal64(nil, value, FormatTrimLeadingZeros))
+		if want := strconv.FormatUint(value, 8); octal != want {
+			t.Fatalf("AppendOctal64(%d) = %q, want %q", value, octal, want)
+		}
+	}
+}
+
+// TestAppendUintRadixInvalidBase checks that the bases outside 2 to 36 are rejected and dst is kept
+func TestAppendUintRadixInvalidBase(t *testing.T) {
+	for _, base := range []int{-1, 0, 1, 37, 64} {
+		dst := []byte("x")
+		if got, err := AppendUintRadix(dst, 10, base, 0); err != ErrorCodeBuffersInvalidBase || string(got) != "x" {
+			t.Errorf("AppendUintRadix(10, %d) = %q, %d", base, got, err)
+		}
+		got, err := AppendUintRadixFixed(dst, 10, base, 4, 0)
+		if err != ErrorCodeBuffersInvalidBase || string(got) != "x" {
+			t.Errorf("AppendUintRadixFixed(10, %d) = %q, %d", base, got, err)
+		}
+	}
+}
+
+// BenchmarkAppendUintRadix formats values of the full uint64 range in bases without a shift, run it with GOARCH=386
+// or GOARCH=arm to see the cost of the 64-bit division routine
+func BenchmarkAppendUintRadix(b *testing.B) {
+	values := benchmarkDecimalValues(math.MaxUint64)
+	var buffer [UintToRadixBufferSize]byte
+	for _, base := range []int{3, 10, 36} {
+		b.Run(strconv.Itoa(base), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				AppendUintRadix(buffer[:0], values[i%len(values)], base, 0)
+			}
+		})
+	}
+}
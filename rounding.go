@@ -0,0 +1,111 @@
+package tinygo_buffers
+
+type (
+	// RoundingMode is the rule used to drop the digits beyond the requested precision
+	RoundingMode uint8
+)
+
+const (
+	// RoundHalfEven rounds to the nearest value, and to the even digit when exactly halfway
+	RoundHalfEven RoundingMode = iota
+
+	// RoundHalfUp rounds to the nearest value, and away from zero when exactly halfway
+	RoundHalfUp
+
+	// RoundTruncate drops the extra digits, rounding toward zero
+	RoundTruncate
+)
+
+// roundDigits rounds the ASCII digits at the end of the given byte slice with the value of the dropped digits
+//
+// Parameters:
+//
+//	dst: The byte slice containing the digits, which may end with a dot.
+//	start: The index of the first digit.
+//	half: The comparison of the dropped digits with half of the last kept digit: negative if below, zero if exactly
+//	halfway and positive if above.
+//
+// Returns:
+//
+// The byte slice, one byte longer if the carry propagated out of the first digit.
+func (m RoundingMode) roundDigits(dst []byte, start int, half int) []byte {
+	lastDigit := dst[len(dst)-1]
+	if lastDigit == '.' {
+		lastDigit = dst[len(dst)-2]
+	}
+
+	roundUp := false
+	switch m {
+	case RoundHalfEven:
+		roundUp = half > 0 || half == 0 && (lastDigit-'0')%2 != 0
+	case RoundHalfUp:
+		roundUp = half >= 0
+	}
+	if roundUp {
+		return incrementDecimalDigits(dst, start)
+	}
+	return dst
+}
+
+// appendFractionDigits appends the decimal digits of a binary fixed point fraction and rounds them with the remaining
+// fraction
+//
+// Parameters:
+//
+//	dst: The byte slice containing the integer digits and the dot.
+//	start: The index of the first digit.
+//	fraction: The fraction scaled by 2^bits, which must be smaller than 2^bits.
+//	bits: The number of fractional bits, at most 60 so the multiplication by 10 does not overflow.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// The extended byte slice, one byte longer if the carry propagated out of the first digit.
+func (m RoundingMode) appendFractionDigits(dst []byte, start int, fraction uint64, bits uint, precision int) []byte {
+	mask := uint64(1)<<bits - 1
+	for i := 0; i < precision; i++ {
+		fraction *= 10
+		dst = append(dst, byte('0'+fraction>>bits))
+		fraction &= mask
+	}
+
+	// Compare the remaining fraction with one half, it is exact so ties are detected
+	half := 0
+	switch {
+	case fraction > 1<<(bits-1):
+		half = 1
+	case fraction < 1<<(bits-1):
+		half = -1
+	}
+	return m.roundDigits(dst, start, half)
+}
+
+// incrementDecimalDigits adds one to the last ASCII digit of the given byte slice, propagating the carry
+//
+// Parameters:
+//
+//	dst: The byte slice containing the digits.
+//	start: The index of the first digit, digits may be separated by a dot.
+//
+// Returns:
+//
+// The byte slice, one byte longer if the carry propagated out of the first digit.
+func incrementDecimalDigits(dst []byte, start int) []byte {
+	for i := len(dst) - 1; i >= start; i-- {
+		switch dst[i] {
+		case '.':
+			continue
+		case '9':
+			dst[i] = ASCIIDecimalDigits[0]
+			continue
+		}
+		dst[i]++
+		return dst
+	}
+
+	// All the digits were 9s, so a leading 1 is inserted
+	dst = append(dst, 0)
+	copy(dst[start+1:], dst[start:])
+	dst[start] = ASCIIDecimalDigits[1]
+	return dst
+}
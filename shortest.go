@@ -0,0 +1,467 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	// pow5TableSize is the number of consecutive powers of five in pow5Table, the other powers are rebuilt from the
+	// split tables every pow5TableSize exponents
+	pow5TableSize = 26
+
+	// pow5BitCount is the number of bits kept of the powers of five, and of their inverses
+	pow5BitCount = 125
+)
+
+// pow5Table contains the powers of five that fit in an uint64, from 5^0 to 5^25
+var pow5Table = [pow5TableSize]uint64{
+	1, 5, 25, 125, 625, 3125, 15625, 78125, 390625, 1953125, 9765625, 48828125, 244140625, 1220703125, 6103515625,
+	30517578125, 152587890625, 762939453125, 3814697265625, 19073486328125, 95367431640625, 476837158203125,
+	2384185791015625, 11920928955078125, 59604644775390625, 298023223876953125,
+}
+
+// pow5Split contains 5^(26*i) truncated to its pow5BitCount leading bits, as the low and high words
+var pow5Split = [...][2]uint64{
+	{0x0000000000000000, 0x1000000000000000}, // 5^0
+	{0x0000000000000000, 0x14ADF4B7320334B9}, // 5^26
+	{0x0E549208B31ADB10, 0x1ABA4714957D300D}, // 5^52
+	{0x6DC6AD264D8F0866, 0x1145B7E285BF98F5}, // 5^78
+	{0xEB1DBD923D8596CA, 0x1652EFDC6018A1FC}, // 5^104
+	{0xB4C1B80B22AE923C, 0x1CDA62055B2D9D83}, // 5^130
+	{0x5BB28B4E8F7E4C30, 0x12A5568B9F52F416}, // 5^156
+	{0xF08AED437682D4FB, 0x1819651531F9E78F}, // 5^182
+	{0xB4EE134AD99BF150, 0x1F25C186A6F04C28}, // 5^208
+	{0x16499ECB70C25F03, 0x1420EB449C8842E6}, // 5^234
+	{0x85A56EAD360865B0, 0x1A03FDE214CAF085}, // 5^260
+	{0x093DB1D57999890B, 0x10CFEB353A97DAD8}, // 5^286
+	{0xCF38BB735E3F36AC, 0x15BAAF44FA52673E}, // 5^312
+}
+
+// pow5InvSplit contains 2^(pow5bits(26*i)-1+pow5BitCount) / 5^(26*i), rounded up, as the low and high words
+var pow5InvSplit = [...][2]uint64{
+	{0x0000000000000001, 0x2000000000000000}, // 5^-0
+	{0x52A6C95FC0655034, 0x18C240C4AECB13BB}, // 5^-26
+	{0x7CA8D50071DFC806, 0x1327FC58DA0F6FF5}, // 5^-52
+	{0x6520247D3556476E, 0x1DA48CE468E7C702}, // 5^-78
+	{0x6139CDD76802E6E9, 0x16EF5B40C2FC7779}, // 5^-104
+	{0xF951A7FF43DE8C79, 0x11BEBDF578B2F391}, // 5^-130
+	{0x7BE8BEE8D6E957E8, 0x1B758D848FAC54B0}, // 5^-156
+	{0x8BD3F9E999A423EA, 0x153EDA614071A3B7}, // 5^-182
+	{0x0848F973CB3EE3CE, 0x10701BD527B4978C}, // 5^-208
+	{0x153285EBB9EFBFA2, 0x196FBB9BB44DB44D}, // 5^-234
+	{0xADEEE7F86C07B696, 0x13AE3591F5B4D936}, // 5^-260
+	{0x4D686A4EAF182222, 0x1E74404F3DAADA91}, // 5^-286
+	{0x98C0A106E09EBD9F, 0x17900EA4FDA7C257}, // 5^-312
+}
+
+// pow5Offsets contains the 2-bit corrections of the powers of five rebuilt by computePow5, 16 per word
+var pow5Offsets = [...]uint32{
+	0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x40000000, 0x59695995, 0x55545555, 0x56555515, 0x41150504,
+	0x40555410, 0x44555145, 0x44504540, 0x45555550, 0x40004000, 0x96440440, 0x55565565, 0x54454045, 0x40154151,
+	0x55559155, 0x51405555, 0x54414105,
+}
+
+// pow5InvOffsets contains the 2-bit corrections of the inverse powers of five rebuilt by computeInvPow5, 16 per word
+var pow5InvOffsets = [...]uint32{
+	0x54544554, 0x04055545, 0x10041000, 0x00400414, 0x40010000, 0x41155555, 0x00000454, 0x00010044, 0x40000000,
+	0x44000041, 0x50454450, 0x55550054, 0x51655554, 0x40004000, 0x01000001, 0x00010500, 0x51515411, 0x05555554,
+	0x50411500,
+}
+
+// AppendFloat64Shortest appends the shortest decimal representation of a float64 value that parses back to the same
+// bits to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float64 value to convert.
+//
+// Returns:
+//
+// The extended byte slice, formatted like strconv.FormatFloat(value, 'g', -1, 64). No allocation is made if dst has
+// enough capacity.
+func AppendFloat64Shortest(dst []byte, value float64) []byte {
+	return appendFloatShortest(dst, math.Float64bits(value), &float64Info)
+}
+
+// AppendFloat32Shortest appends the shortest decimal representation of a float32 value that parses back to the same
+// bits to the given byte slice
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	value: The float32 value to convert.
+//
+// Returns:
+//
+// The extended byte slice, formatted like strconv.FormatFloat(float64(value), 'g', -1, 32). No allocation is made if
+// dst has enough capacity.
+func AppendFloat32Shortest(dst []byte, value float32) []byte {
+	return appendFloatShortest(dst, uint64(math.Float32bits(value)), &float32Info)
+}
+
+// appendFloatShortest appends the shortest decimal representation of a binary floating point number
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	bits: The bits of the floating point number.
+//	flt: The layout of the floating point format.
+//
+// Returns:
+//
+// The extended byte slice.
+func appendFloatShortest(dst []byte, bits uint64, flt *floatInfo) []byte {
+	// Check for the special values
+	if int(bits>>flt.mantBits)&(1<<flt.expBits-1) == 1<<flt.expBits-1 {
+		switch {
+		case bits&(1<<flt.mantBits-1) != 0:
+			return append(dst, NaNBuffer...)
+		case bits>>(flt.expBits+flt.mantBits) != 0:
+			return append(dst, NegativeInfBuffer...)
+		}
+		return append(dst, PositiveInfBuffer...)
+	}
+
+	// Get the shortest digits, which are never shifted, so the storage only needs room for an uint64
+	var digits [UintToDecimalBufferSize + decimalShiftSlack]byte
+	d := decimal{d: digits[:]}
+	if bits&(1<<(flt.expBits+flt.mantBits)-1) == 0 {
+		d.assign(0)
+	} else {
+		output, exp10 := shortestDecimal(bits, flt)
+		d.assign(output)
+		d.dp += exp10
+	}
+	d.neg = bits>>(flt.expBits+flt.mantBits) != 0
+
+	// Use the exponent notation for large and small exponents
+	if exp := d.dp - 1; exp < -4 || exp >= 6 {
+		return d.appendExponent(dst, d.nd-1)
+	}
+	fraction := d.nd - d.dp
+	if fraction < 0 {
+		fraction = 0
+	}
+	return d.appendFixed(dst, fraction)
+}
+
+// shortestDecimal computes the shortest decimal that parses back to a nonzero finite binary floating point number,
+// using the Ryu algorithm: the value and the halfway points to its neighbours are scaled to a power of ten with
+// 128-bit arithmetic, then digits are removed while the bounds still differ
+//
+// Parameters:
+//
+//	bits: The bits of the floating point number, which must be finite and nonzero.
+//	flt: The layout of the floating point format.
+//
+// Returns:
+//
+// The decimal mantissa and its power of ten exponent.
+func shortestDecimal(bits uint64, flt *floatInfo) (uint64, int) {
+	// Split the bits, with two extra bits of exponent so the halfway points are integers
+	mantissa := bits & (1<<flt.mantBits - 1)
+	exponent := int(bits>>flt.mantBits) & (1<<flt.expBits - 1)
+	m2 := mantissa
+	e2 := 1 + flt.bias - int(flt.mantBits) - 2
+	if exponent != 0 {
+		m2 |= 1 << flt.mantBits
+		e2 = exponent + flt.bias - int(flt.mantBits) - 2
+	}
+
+	// The bounds are valid outputs only if the mantissa is even, since ties are parsed to the even mantissa. The
+	// lower bound is closer when the mantissa is a power of two above the minimum exponent
+	acceptBounds := m2%2 == 0
+	mv := 4 * m2
+	mmShift := uint64(0)
+	if mantissa != 0 || exponent <= 1 {
+		mmShift = 1
+	}
+
+	// Scale the value and its bounds to a power of ten, keeping whether the dropped digits are all zeros
+	var vr, vp, vm uint64
+	var e10 int
+	vmIsTrailingZeros, vrIsTrailingZeros := false, false
+	if e2 >= 0 {
+		q := log10Pow2(e2)
+		if e2 > 3 {
+			q--
+		}
+		e10 = q
+		mulLo, mulHi := computeInvPow5(q)
+		j := -e2 + q + pow5BitCount + pow5bits(q) - 1
+		vr = mulShift64(mv, mulLo, mulHi, j)
+		vp = mulShift64(mv+2, mulLo, mulHi, j)
+		vm = mulShift64(mv-1-mmShift, mulLo, mulHi, j)
+
+		// Only one of the value and its bounds can be a multiple of 5, if any
+		if q <= 21 {
+			switch {
+			case mv%5 == 0:
+				vrIsTrailingZeros = multipleOfPowerOf5(mv, q)
+			case acceptBounds:
+				vmIsTrailingZeros = multipleOfPowerOf5(mv-1-mmShift, q)
+			case multipleOfPowerOf5(mv+2, q):
+				vp--
+			}
+		}
+	} else {
+		q := log10Pow5(-e2)
+		if -e2 > 1 {
+			q--
+		}
+		e10 = q + e2
+		i := -e2 - q
+		mulLo, mulHi := computePow5(i)
+		j := q - pow5bits(i) + pow5BitCount
+		vr = mulShift64(mv, mulLo, mulHi, j)
+		vp = mulShift64(mv+2, mulLo, mulHi, j)
+		vm = mulShift64(mv-1-mmShift, mulLo, mulHi, j)
+
+		// The value and its bounds have at least q trailing zeros if they have q trailing zero bits
+		switch {
+		case q <= 1:
+			vrIsTrailingZeros = true
+			if acceptBounds {
+				vmIsTrailingZeros = mmShift == 1
+			} else {
+				vp--
+			}
+		case q < 63:
+			vrIsTrailingZeros = multipleOfPowerOf2(mv, q)
+		}
+	}
+
+	// Remove digits while the bounds still differ, remembering the last removed digit of the value
+	removed := 0
+	lastRemovedDigit := uint64(0)
+	for vp/10 > vm/10 {
+		vmIsTrailingZeros = vmIsTrailingZeros && vm%10 == 0
+		vrIsTrailingZeros = vrIsTrailingZeros && lastRemovedDigit == 0
+		lastRemovedDigit = vr % 10
+		vr, vp, vm = vr/10, vp/10, vm/10
+		removed++
+	}
+
+	// An inclusive lower bound ending in zeros allows removing more digits
+	if vmIsTrailingZeros {
+		for vm%10 == 0 {
+			vrIsTrailingZeros = vrIsTrailingZeros && lastRemovedDigit == 0
+			lastRemovedDigit = vr % 10
+			vr, vp, vm = vr/10, vp/10, vm/10
+			removed++
+		}
+	}
+
+	// Round half to even if the removed digits are exactly 50...0, and round up when the value is outside the bounds
+	if vrIsTrailingZeros && lastRemovedDigit == 5 && vr%2 == 0 {
+		lastRemovedDigit = 4
+	}
+	if vr == vm && (!acceptBounds || !vmIsTrailingZeros) || lastRemovedDigit >= 5 {
+		vr++
+	}
+	return vr, e10 + removed
+}
+
+// computePow5 returns 5^i truncated to its pow5BitCount leading bits, rebuilt from the nearest smaller split power
+//
+// Parameters:
+//
+//	i: The exponent, smaller than 336.
+//
+// Returns:
+//
+// The low and high words of the power.
+func computePow5(i int) (uint64, uint64) {
+	base := i / pow5TableSize
+	base2 := base * pow5TableSize
+	offset := i - base2
+	mul := pow5Split[base]
+	if offset == 0 {
+		return mul[0], mul[1]
+	}
+
+	// Multiply by the remaining power of five, realign the product and add the stored correction
+	m := pow5Table[offset]
+	high0, low0 := bits.Mul64(m, mul[0])
+	high2, low2 := bits.Mul64(m, mul[1])
+	delta := uint(pow5bits(i) - pow5bits(base2))
+	low, carry := bits.Add64(low0>>delta|high0<<(64-delta), low2<<(64-delta), 0)
+	high := high0>>delta + (high2<<(64-delta) | low2>>delta) + carry
+	low, carry = bits.Add64(low, uint64(pow5Offsets[i/16]>>((i%16)<<1)&3), 0)
+	return low, high + carry
+}
+
+// computeInvPow5 returns 2^(pow5bits(i)-1+pow5BitCount) / 5^i rounded up, rebuilt from the nearest larger split
+// inverse power
+//
+// Parameters:
+//
+//	i: The exponent, smaller than 304.
+//
+// Returns:
+//
+// The low and high words of the inverse power.
+func computeInvPow5(i int) (uint64, uint64) {
+	base := (i + pow5TableSize - 1) / pow5TableSize
+	base2 := base * pow5TableSize
+	offset := base2 - i
+	mul := pow5InvSplit[base]
+	if offset == 0 {
+		return mul[0], mul[1]
+	}
+
+	// Multiply by the remaining power of five, realign the product and add the stored correction
+	m := pow5Table[offset]
+	high0, low0 := bits.Mul64(m, mul[0]-1)
+	high2, low2 := bits.Mul64(m, mul[1])
+	delta := uint(pow5bits(base2) - pow5bits(i))
+	low, carry := bits.Add64(low0>>delta|high0<<(64-delta), low2<<(64-delta), 0)
+	high := high0>>delta + (high2<<(64-delta) | low2>>delta) + carry
+	low, carry = bits.Add64(low, 1+uint64(pow5InvOffsets[i/16]>>((i%16)<<1)&3), 0)
+	return low, high + carry
+}
+
+// mulShift64 returns the product of an uint64 and a 128-bit factor, shifted right by j bits
+//
+// Parameters:
+//
+//	m: The uint64 factor.
+//	mulLo: The low word of the 128-bit factor.
+//	mulHi: The high word of the 128-bit factor.
+//	j: The shift, at least 64.
+//
+// Returns:
+//
+// The low 64 bits of the shifted product.
+func mulShift64(m, mulLo, mulHi uint64, j int) uint64 {
+	high0, _ := bits.Mul64(m, mulLo)
+	high2, low2 := bits.Mul64(m, mulHi)
+	sum, carry := bits.Add64(low2, high0, 0)
+	high2 += carry
+	shift := uint(j - 64)
+	return high2<<(64-shift) | sum>>shift
+}
+
+// pow5bits returns the number of bits of 5^e, or 1 if e is 0
+func pow5bits(e int) int {
+	return (e*1217359)>>19 + 1
+}
+
+// log10Pow2 returns floor(log10(2^e))
+func log10Pow2(e int) int {
+	return (e * 78913) >> 18
+}
+
+// log10Pow5 returns floor(log10(5^e))
+func log10Pow5(e int) int {
+	return (e * 732923) >> 20
+}
+
+// multipleOfPowerOf5 reports whether value is divisible by 5^p
+func multipleOfPowerOf5(value uint64, p int) bool {
+	count := 0
+	for value%5 == 0 {
+		value /= 5
+		count++
+	}
+	return count >= p
+}
+
+// multipleOfPowerOf2 reports whether value is divisible by 2^p
+func multipleOfPowerOf2(value uint64, p int) bool {
+	return value&(1<<p-1) == 0
+}
+
+// appendExponent appends the decimal in exponent notation, like "-1.25e+06"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	precision: The number of digits after the decimal point.
+//
+// Returns:
+//
+// The extended byte slice.
+func (a *decimal) appendExponent(dst []byte, precision int) []byte {
+	if a.neg {
+		dst = append(dst, '-')
+	}
+
+	// Write the first digit, then the dot and the remaining digits
+	first := ASCIIDecimalDigits[0]
+	if a.nd > 0 {
+		first = a.d[0]
+	}
+	dst = append(dst, first)
+	if precision > 0 {
+		dst = append(dst, '.')
+		i := 1
+		for ; i < a.nd && i <= precision; i++ {
+			dst = append(dst, a.d[i])
+		}
+		for ; i <= precision; i++ {
+			dst = append(dst, ASCIIDecimalDigits[0])
+		}
+	}
+
+	// Write the exponent with at least two digits
+	exp := a.dp - 1
+	if a.nd == 0 {
+		exp = 0
+	}
+	dst = append(dst, 'e')
+	if exp < 0 {
+		dst = append(dst, '-')
+		exp = -exp
+	} else {
+		dst = append(dst, '+')
+	}
+	if exp < 10 {
+		dst = append(dst, ASCIIDecimalDigits[0])
+	}
+	return AppendUintDecimal(dst, uint64(exp))
+}
+
+// appendFixed appends the decimal in fixed notation, like "-1250000.5"
+//
+// Parameters:
+//
+//	dst: The byte slice to append to.
+//	precision: The number of digits after the decimal point, the dot is omitted if it is zero.
+//
+// Returns:
+//
+// The extended byte slice.
+func (a *decimal) appendFixed(dst []byte, precision int) []byte {
+	if a.neg {
+		dst = append(dst, '-')
+	}
+
+	// Write the integer part
+	if a.dp > 0 {
+		i := 0
+		for ; i < a.dp && i < a.nd; i++ {
+			dst = append(dst, a.d[i])
+		}
+		for ; i < a.dp; i++ {
+			dst = append(dst, ASCIIDecimalDigits[0])
+		}
+	} else {
+		dst = append(dst, ASCIIDecimalDigits[0])
+	}
+
+	// Write the fractional part
+	if precision > 0 {
+		dst = append(dst, '.')
+		for i := 0; i < precision; i++ {
+			digit := ASCIIDecimalDigits[0]
+			if j := a.dp + i; j >= 0 && j < a.nd {
+				digit = a.d[j]
+			}
+			dst = append(dst, digit)
+		}
+	}
+	return dst
+}
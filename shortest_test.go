@@ -0,0 +1,142 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// shortestFloat64Tests are values at the edges of the float64 range and of the Ryu trailing zero cases
+var shortestFloat64Tests = []float64{
+	0, math.Copysign(0, -1), 1, -1, 0.1, 0.3, 1.0 / 3, 2.5, 100, 1e6, 123456, 1234567, 1e21, 1e22, 1e23, 9007199254740993,
+	5e-324, 1e-323, 2.2250738585072009e-308, 2.2250738585072014e-308, math.MaxFloat64, 1.7976931348623155e308,
+	0.0001, 0.00001, 1e-5, 5e-5, 2.98023223876953125e-8, 9.5367431640625e-7, 1 << 53, 1 << 63, 1 << 64,
+	1.5e300, 4.940656458412e-324, 9.999999999999999e22, 1.8446744073709552e19, 0.000123, 299792458,
+}
+
+// TestAppendFloat64Shortest compares with strconv and checks that the output parses back to the same bits
+func TestAppendFloat64Shortest(t *testing.T) {
+	check := func(value float64) {
+		got := string(AppendFloat64Shortest(nil, value))
+		if want := strconv.FormatFloat(value, 'g', -1, 64); got != want {
+			t.Fatalf("AppendFloat64Shortest(%b) = %q, want %q", value, got, want)
+		}
+		parsed, err := strconv.ParseFloat(got, 64)
+		if err != nil || math.Float64bits(parsed) != math.Float64bits(value) {
+			t.Fatalf("AppendFloat64Shortest(%b) = %q, which parses back to %b", value, got, parsed)
+		}
+	}
+
+	for _, value := range shortestFloat64Tests {
+		check(value)
+		check(-value)
+	}
+
+	// Powers of two and their neighbours, whose lower bound is closer
+	for exp := -1074; exp <= 1023; exp++ {
+		value := math.Ldexp(1, exp)
+		check(value)
+		check(math.Nextafter(value, 0))
+		check(math.Nextafter(value, math.Inf(1)))
+	}
+
+	// Powers of ten and their neighbours, which end in trailing zeros
+	for exp := -323; exp <= 308; exp++ {
+		value, _ := strconv.ParseFloat("1e"+strconv.Itoa(exp), 64)
+		check(value)
+		check(math.Nextafter(value, 0))
+		check(math.Nextafter(value, math.Inf(1)))
+	}
+
+	// Random bits of every magnitude
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 300000; i++ {
+		value := math.Float64frombits(rng.Uint64())
+		if !math.IsNaN(value) && !math.IsInf(value, 0) {
+			check(value)
+		}
+	}
+
+	// Integers and short decimals, which have trailing zeros
+	for i := 0; i < 100000; i++ {
+		check(float64(rng.Int63n(1 << 54)))
+		check(float64(rng.Int63n(1000000)) / 1000)
+	}
+}
+
+// TestAppendFloat32Shortest compares with strconv and checks that the output parses back to the same bits
+func TestAppendFloat32Shortest(t *testing.T) {
+	check := func(value float32) {
+		got := string(AppendFloat32Shortest(nil, value))
+		if want := strconv.FormatFloat(float64(value), 'g', -1, 32); got != want {
+			t.Fatalf("AppendFloat32Shortest(%b) = %q, want %q", value, got, want)
+		}
+		parsed, err := strconv.ParseFloat(got, 32)
+		if err != nil || math.Float32bits(float32(parsed)) != math.Float32bits(value) {
+			t.Fatalf("AppendFloat32Shortest(%b) = %q, which parses back to %b", value, got, float32(parsed))
+		}
+	}
+
+	for _, value := range []float32{
+		0, 1, 0.1, 0.3, 1.0 / 3, 16777216, 16777217, 1e10, math.MaxFloat32, math.SmallestNonzeroFloat32,
+		1.1754942e-38, 1.17549435e-38, 3.4028233e38, 8.589973e9, 1e-7,
+	} {
+		check(value)
+		check(-value)
+	}
+	for exp := -149; exp <= 127; exp++ {
+		value := float32(math.Ldexp(1, exp))
+		check(value)
+		check(math.Nextafter32(value, 0))
+		check(math.Nextafter32(value, float32(math.Inf(1))))
+	}
+
+	// Every 251st bit pattern, which covers every exponent with varied mantissas
+	for bits := uint32(0); bits < 0x7F800000; bits += 251 {
+		check(math.Float32frombits(bits))
+	}
+}
+
+// TestShortestTables checks the powers of five rebuilt from the compressed tables against exact big integer values
+func TestShortestTables(t *testing.T) {
+	for i := 0; i < 16*len(pow5Offsets); i++ {
+		low, high := computePow5(i)
+		if got, want := [2]uint64{low, high}, pow5Exact(i); got != want {
+			t.Fatalf("computePow5(%d) = %x, want %x", i, got, want)
+		}
+	}
+	for i := 0; i < 16*len(pow5InvOffsets); i++ {
+		low, high := computeInvPow5(i)
+		if got, want := [2]uint64{low, high}, pow5InvExact(i); got != want {
+			t.Fatalf("computeInvPow5(%d) = %x, want %x", i, got, want)
+		}
+	}
+}
+
+// pow5Exact returns 5^i truncated to its pow5BitCount leading bits
+func pow5Exact(i int) [2]uint64 {
+	pow := new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(i)), nil)
+	if shift := pow.BitLen() - pow5BitCount; shift > 0 {
+		pow.Rsh(pow, uint(shift))
+	} else {
+		pow.Lsh(pow, uint(-shift))
+	}
+	return bigWords(pow)
+}
+
+// pow5InvExact returns 2^(pow5bits(i)-1+pow5BitCount) / 5^i rounded up
+func pow5InvExact(i int) [2]uint64 {
+	pow := new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(i)), nil)
+	inv := new(big.Int).Lsh(big.NewInt(1), uint(pow5bits(i)-1+pow5BitCount))
+	inv.Div(inv, pow)
+	return bigWords(inv.Add(inv, big.NewInt(1)))
+}
+
+// bigWords splits a 128-bit integer into its low and high words
+func bigWords(value *big.Int) [2]uint64 {
+	high := new(big.Int).Rsh(value, 64)
+	low := new(big.Int).Sub(value, new(big.Int).Lsh(high, 64))
+	return [2]uint64{low.Uint64(), high.Uint64()}
+}
@@ -0,0 +1,177 @@
+package tinygo_buffers
+
+import (
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// Uint24ToBytes converts an uint32 value to an array of 3 bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	value: The uint32 value to convert, only its low 24 bits are stored.
+//	buffer: A byte slice to store the resulting bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Uint24ToBytes(value uint32, buffer []byte) tinygoerrors.ErrorCode {
+	// Ensure the buffer has at least 3 bytes
+	if len(buffer) < 3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	buffer[0] = byte(value >> 16)
+	buffer[1] = byte(value >> 8)
+	buffer[2] = byte(value)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// Int24ToBytes converts an int32 value to an array of 3 bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	value: The int32 value to convert, only its low 24 bits are stored.
+//	buffer: A byte slice to store the resulting bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Int24ToBytes(value int32, buffer []byte) tinygoerrors.ErrorCode {
+	return Uint24ToBytes(uint32(value), buffer)
+}
+
+// Uint24ToBytesLE converts an uint32 value to an array of 3 bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	value: The uint32 value to convert, only its low 24 bits are stored.
+//	buffer: A byte slice to store the resulting bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Uint24ToBytesLE(value uint32, buffer []byte) tinygoerrors.ErrorCode {
+	// Ensure the buffer has at least 3 bytes
+	if len(buffer) < 3 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	buffer[0] = byte(value)
+	buffer[1] = byte(value >> 8)
+	buffer[2] = byte(value >> 16)
+	return tinygoerrors.ErrorCodeNil
+}
+
+// Int24ToBytesLE converts an int32 value to an array of 3 bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	value: The int32 value to convert, only its low 24 bits are stored.
+//	buffer: A byte slice to store the resulting bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Int24ToBytesLE(value int32, buffer []byte) tinygoerrors.ErrorCode {
+	return Uint24ToBytesLE(uint32(value), buffer)
+}
+
+// BytesToUint24 converts a byte slice to an uint32 value in big-endian order
+//
+// Parameters:
+//
+//	data: A byte slice containing at least 3 bytes.
+//
+// Returns:
+//
+// The uint32 value represented by the first 3 bytes of the input slice, or an error code if the input is invalid.
+func BytesToUint24(data []byte) (uint32, tinygoerrors.ErrorCode) {
+	if len(data) < 3 {
+		return 0, ErrorCodeBuffersInvalidBufferSize
+	}
+	return uint32(data[0])<<16 | uint32(data[1])<<8 | uint32(data[2]), tinygoerrors.ErrorCodeNil
+}
+
+// BytesToInt24 converts a byte slice to an int32 value in big-endian order, sign-extended from 24 bits
+//
+// Parameters:
+//
+//	data: A byte slice containing at least 3 bytes.
+//
+// Returns:
+//
+// The int32 value represented by the first 3 bytes of the input slice, or an error code if the input is invalid.
+func BytesToInt24(data []byte) (int32, tinygoerrors.ErrorCode) {
+	u, err := BytesToUint24(data)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return signExtend32(u, 24), tinygoerrors.ErrorCodeNil
+}
+
+// BytesToUint24LE converts a byte slice to an uint32 value in little-endian order
+//
+// Parameters:
+//
+//	data: A byte slice containing at least 3 bytes.
+//
+// Returns:
+//
+// The uint32 value represented by the first 3 bytes of the input slice, or an error code if the input is invalid.
+func BytesToUint24LE(data []byte) (uint32, tinygoerrors.ErrorCode) {
+	if len(data) < 3 {
+		return 0, ErrorCodeBuffersInvalidBufferSize
+	}
+	return uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16, tinygoerrors.ErrorCodeNil
+}
+
+// BytesToInt24LE converts a byte slice to an int32 value in little-endian order, sign-extended from 24 bits
+//
+// Parameters:
+//
+//	data: A byte slice containing at least 3 bytes.
+//
+// Returns:
+//
+// The int32 value represented by the first 3 bytes of the input slice, or an error code if the input is invalid.
+func BytesToInt24LE(data []byte) (int32, tinygoerrors.ErrorCode) {
+	u, err := BytesToUint24LE(data)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return signExtend32(u, 24), tinygoerrors.ErrorCodeNil
+}
+
+// SignExtend interprets the low bits of a value as a two's complement number, like a 20-bit or 24-bit ADC sample
+//
+// Parameters:
+//
+//	value: The raw value, the bits above the given width are ignored.
+//	bits: The width of the number in bits, from 1 to 64.
+//
+// Returns:
+//
+// The signed value, or 0 if bits is not positive.
+func SignExtend(value uint64, bits int) int64 {
+	switch {
+	case bits <= 0:
+		return 0
+	case bits >= 64:
+		return int64(value)
+	}
+	shift := 64 - bits
+	return int64(value<<shift) >> shift
+}
+
+// signExtend32 interprets the low bits of an uint32 value as a two's complement number using 32-bit arithmetic
+//
+// Parameters:
+//
+//	value: The raw value.
+//	bits: The width of the number in bits, from 1 to 32.
+//
+// Returns:
+//
+// The signed value.
+func signExtend32(value uint32, bits int) int32 {
+	shift := 32 - bits
+	return int32(value<<shift) >> shift
+}
@@ -0,0 +1,129 @@
+package tinygo_buffers
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// int24Tests are 24-bit values with their big-endian encoding, at the limits of the signed range
+var int24Tests = []struct {
+	encoded  []byte
+	unsigned uint32
+	signed   int32
+}{
+	{[]byte{0x00, 0x00, 0x00}, 0, 0},
+	{[]byte{0x00, 0x00, 0x01}, 1, 1},
+	{[]byte{0x12, 0x34, 0x56}, 0x123456, 0x123456},
+	{[]byte{0x7F, 0xFF, 0xFF}, 0x7FFFFF, 8388607},
+	{[]byte{0x80, 0x00, 0x00}, 0x800000, -8388608},
+	{[]byte{0x80, 0x00, 0x01}, 0x800001, -8388607},
+	{[]byte{0xFF, 0xFF, 0xFE}, 0xFFFFFE, -2},
+	{[]byte{0xFF, 0xFF, 0xFF}, 0xFFFFFF, -1},
+}
+
+// TestInt24 checks the 24-bit converters in both byte orders against fixed encodings
+func TestInt24(t *testing.T) {
+	for _, test := range int24Tests {
+		little := []byte{test.encoded[2], test.encoded[1], test.encoded[0]}
+		if got, err := BytesToUint24(test.encoded); got != test.unsigned || err != tinygoerrors.ErrorCodeNil {
+			t.Errorf("BytesToUint24(% X) = %#x, %d, want %#x", test.encoded, got, err, test.unsigned)
+		}
+		if got, err := BytesToUint24LE(little); got != test.unsigned || err != tinygoerrors.ErrorCodeNil {
+			t.Errorf("BytesToUint24LE(% X) = %#x, %d, want %#x", little, got, err, test.unsigned)
+		}
+		if got, err := BytesToInt24(test.encoded); got != test.signed || err != tinygoerrors.ErrorCodeNil {
+			t.Errorf("BytesToInt24(% X) = %d, %d, want %d", test.encoded, got, err, test.signed)
+		}
+		if got, err := BytesToInt24LE(little); got != test.signed || err != tinygoerrors.ErrorCodeNil {
+			t.Errorf("BytesToInt24LE(% X) = %d, %d, want %d", little, got, err, test.signed)
+		}
+
+		// The encoders write exactly 3 bytes, so a guard byte after them is kept
+		buffer := []byte{0, 0, 0, 0xA5}
+		checkEncoded(t, "Uint24ToBytes", buffer, Uint24ToBytes(test.unsigned, buffer), test.encoded)
+		checkEncoded(t, "Uint24ToBytesLE", buffer, Uint24ToBytesLE(test.unsigned, buffer), little)
+		checkEncoded(t, "Int24ToBytes", buffer, Int24ToBytes(test.signed, buffer), test.encoded)
+		checkEncoded(t, "Int24ToBytesLE", buffer, Int24ToBytesLE(test.signed, buffer), little)
+	}
+}
+
+// checkEncoded checks the error and the bytes written by an encoder, and that the guard byte after them is kept
+//
+// Parameters:
+//
+//	t: The test.
+//	name: The name of the encoder.
+//	buffer: The buffer written by the encoder, ending with the 0xA5 guard byte.
+//	err: The error returned by the encoder.
+//	want: The expected bytes.
+func checkEncoded(t *testing.T, name string, buffer []byte, err tinygoerrors.ErrorCode, want []byte) {
+	t.Helper()
+	if err != tinygoerrors.ErrorCodeNil || !bytes.Equal(buffer[:len(want)], want) || buffer[len(buffer)-1] != 0xA5 {
+		t.Errorf("%s wrote % X, %d, want % X", name, buffer, err, want)
+	}
+}
+
+// TestInt24HighBits checks that the bits above 24 are dropped on encoding
+func TestInt24HighBits(t *testing.T) {
+	const value = 0xAB123456
+	buffer := []byte{0, 0, 0, 0xA5}
+	checkEncoded(t, "Uint24ToBytes", buffer, Uint24ToBytes(value, buffer), []byte{0x12, 0x34, 0x56})
+	checkEncoded(t, "Uint24ToBytesLE", buffer, Uint24ToBytesLE(value, buffer), []byte{0x56, 0x34, 0x12})
+	checkEncoded(t, "Int24ToBytes(MaxInt32)", buffer, Int24ToBytes(math.MaxInt32, buffer), []byte{0xFF, 0xFF, 0xFF})
+	checkEncoded(t, "Int24ToBytes(MinInt32)", buffer, Int24ToBytes(math.MinInt32, buffer), []byte{0x00, 0x00, 0x00})
+	checkEncoded(t, "Int24ToBytesLE(1<<23)", buffer, Int24ToBytesLE(1<<23, buffer), []byte{0x00, 0x00, 0x80})
+}
+
+// TestInt24ShortBuffer checks that buffers shorter than 3 bytes are rejected
+func TestInt24ShortBuffer(t *testing.T) {
+	short := make([]byte, 2)
+	for name, err := range map[string]tinygoerrors.ErrorCode{
+		"Uint24ToBytes":   Uint24ToBytes(1, short),
+		"Uint24ToBytesLE": Uint24ToBytesLE(1, short),
+		"Int24ToBytes":    Int24ToBytes(1, short),
+		"Int24ToBytesLE":  Int24ToBytesLE(1, short),
+	} {
+		if err != ErrorCodeBuffersInvalidBufferSize {
+			t.Errorf("%s with a 2 byte buffer returned error %d", name, err)
+		}
+	}
+	if _, err := BytesToUint24(short); err != ErrorCodeBuffersInvalidBufferSize {
+		t.Errorf("BytesToUint24 with 2 bytes returned error %d", err)
+	}
+	if _, err := BytesToInt24LE(short); err != ErrorCodeBuffersInvalidBufferSize {
+		t.Errorf("BytesToInt24LE with 2 bytes returned error %d", err)
+	}
+}
+
+// TestSignExtend checks the sign bit of several widths, the bits above the width and the invalid widths
+func TestSignExtend(t *testing.T) {
+	for _, test := range []struct {
+		value uint64
+		bits  int
+		want  int64
+	}{
+		{0x1, 1, -1},
+		{0x0, 1, 0},
+		{0x7FFFF, 20, 524287},
+		{0x80000, 20, -524288},
+		{0xFFF80000, 20, -524288},
+		{0x800000, 24, -8388608},
+		{0x7FFFFF, 24, 8388607},
+		{0xFF7FFFFF, 24, 8388607},
+		{0xFFFFFFFFFFFF, 48, -1},
+		{0x7FFFFFFFFFFF, 48, 1<<47 - 1},
+		{0x800000000000, 48, -1 << 47},
+		{math.MaxUint64, 64, -1},
+		{1 << 63, 64, math.MinInt64},
+		{1 << 63, 65, math.MinInt64},
+		{0xFF, 0, 0},
+		{0xFF, -1, 0},
+	} {
+		if got := SignExtend(test.value, test.bits); got != test.want {
+			t.Errorf("SignExtend(%#x, %d) = %d, want %d", test.value, test.bits, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package tinygo_buffers
+
+import (
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// Uint48ToBytes converts an uint64 value to an array of 6 bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	value: The uint64 value to convert, only its low 48 bits are stored.
+//	buffer: A byte slice to store the resulting bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Uint48ToBytes(value uint64, buffer []byte) tinygoerrors.ErrorCode {
+	// Ensure the buffer has at least 6 bytes
+	if len(buffer) < 6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	Uint16ToBytes(uint16(value>>32), buffer)
+	return Uint32ToBytes(uint32(value), buffer[2:])
+}
+
+// Int48ToBytes converts an int64 value to an array of 6 bytes in big-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	value: The int64 value to convert, only its low 48 bits are stored.
+//	buffer: A byte slice to store the resulting bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Int48ToBytes(value int64, buffer []byte) tinygoerrors.ErrorCode {
+	return Uint48ToBytes(uint64(value), buffer)
+}
+
+// Uint48ToBytesLE converts an uint64 value to an array of 6 bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	value: The uint64 value to convert, only its low 48 bits are stored.
+//	buffer: A byte slice to store the resulting bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Uint48ToBytesLE(value uint64, buffer []byte) tinygoerrors.ErrorCode {
+	// Ensure the buffer has at least 6 bytes
+	if len(buffer) < 6 {
+		return ErrorCodeBuffersInvalidBufferSize
+	}
+	Uint32ToBytesLE(uint32(value), buffer)
+	return Uint16ToBytesLE(uint16(value>>32), buffer[4:])
+}
+
+// Int48ToBytesLE converts an int64 value to an array of 6 bytes in little-endian order, storing the result in the provided buffer
+//
+// Parameters:
+//
+//	value: The int64 value to convert, only its low 48 bits are stored.
+//	buffer: A byte slice to store the resulting bytes.
+//
+// Returns:
+//
+// An error code indicating success or failure.
+func Int48ToBytesLE(value int64, buffer []byte) tinygoerrors.ErrorCode {
+	return Uint48ToBytesLE(uint64(value), buffer)
+}
+
+// BytesToUint48 converts a byte slice to an uint64 value in big-endian order
+//
+// Parameters:
+//
+//	data: A byte slice containing at least 6 bytes.
+//
+// Returns:
+//
+// The uint64 value represented by the first 6 bytes of the input slice, or an error code if the input is invalid.
+func BytesToUint48(data []byte) (uint64, tinygoerrors.ErrorCode) {
+	if len(data) < 6 {
+		return 0, ErrorCodeBuffersInvalidBufferSize
+	}
+	high, _ := BytesToUint16(data)
+	low, _ := BytesToUint32(data[2:])
+	return uint64(high)<<32 | uint64(low), tinygoerrors.ErrorCodeNil
+}
+
+// BytesToInt48 converts a byte slice to an int64 value in big-endian order, sign-extended from 48 bits
+//
+// Parameters:
+//
+//	data: A byte slice containing at least 6 bytes.
+//
+// Returns:
+//
+// The int64 value represented by the first 6 bytes of the input slice, or an error code if the input is invalid.
+func BytesToInt48(data []byte) (int64, tinygoerrors.ErrorCode) {
+	u, err := BytesToUint48(data)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return SignExtend(u, 48), tinygoerrors.ErrorCodeNil
+}
+
+// BytesToUint48LE converts a byte slice to an uint64 value in little-endian order
+//
+// Parameters:
+//
+//	data: A byte slice containing at least 6 bytes.
+//
+// Returns:
+//
+// The uint64 value represented by the first 6 bytes of the input slice, or an error code if the input is invalid.
+func BytesToUint48LE(data []byte) (uint64, tinygoerrors.ErrorCode) {
+	if len(data) < 6 {
+		return 0, ErrorCodeBuffersInvalidBufferSize
+	}
+	low, _ := BytesToUint32LE(data)
+	high, _ := BytesToUint16LE(data[4:])
+	return uint64(high)<<32 | uint64(low), tinygoerrors.ErrorCodeNil
+}
+
+// BytesToInt48LE converts a byte slice to an int64 value in little-endian order, sign-extended from 48 bits
+//
+// Parameters:
+//
+//	data: A byte slice containing at least 6 bytes.
+//
+// Returns:
+//
+// The int64 value represented by the first 6 bytes of the input slice, or an error code if the input is invalid.
+func BytesToInt48LE(data []byte) (int64, tinygoerrors.ErrorCode) {
+	u, err := BytesToUint48LE(data)
+	if err != tinygoerrors.ErrorCodeNil {
+		return 0, err
+	}
+	return SignExtend(u, 48), tinygoerrors.ErrorCodeNil
+}
@@ -0,0 +1,87 @@
+package tinygo_buffers
+
+import (
+	"math"
+	"testing"
+
+	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
+)
+
+// int48Tests are 48-bit values with their big-endian encoding, at the limits of the signed range
+var int48Tests = []struct {
+	encoded  []byte
+	unsigned uint64
+	signed   int64
+}{
+	{[]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, 0, 0},
+	{[]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x01}, 1, 1},
+	{[]byte{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}, 0x123456789ABC, 0x123456789ABC},
+	{[]byte{0x7F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, 0x7FFFFFFFFFFF, 140737488355327},
+	{[]byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00}, 0x800000000000, -140737488355328},
+	{[]byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x01}, 0x800000000001, -140737488355327},
+	{[]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE}, 0xFFFFFFFFFFFE, -2},
+	{[]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, 0xFFFFFFFFFFFF, -1},
+}
+
+// TestInt48 checks the 48-bit converters in both byte orders against fixed encodings
+func TestInt48(t *testing.T) {
+	for _, test := range int48Tests {
+		little := make([]byte, 6)
+		for i, c := range test.encoded {
+			little[5-i] = c
+		}
+		if got, err := BytesToUint48(test.encoded); got != test.unsigned || err != tinygoerrors.ErrorCodeNil {
+			t.Errorf("BytesToUint48(% X) = %#x, %d, want %#x", test.encoded, got, err, test.unsigned)
+		}
+		if got, err := BytesToUint48LE(little); got != test.unsigned || err != tinygoerrors.ErrorCodeNil {
+			t.Errorf("BytesToUint48LE(% X) = %#x, %d, want %#x", little, got, err, test.unsigned)
+		}
+		if got, err := BytesToInt48(test.encoded); got != test.signed || err != tinygoerrors.ErrorCodeNil {
+			t.Errorf("BytesToInt48(% X) = %d, %d, want %d", test.encoded, got, err, test.signed)
+		}
+		if got, err := BytesToInt48LE(little); got != test.signed || err != tinygoerrors.ErrorCodeNil {
+			t.Errorf("BytesToInt48LE(% X) = %d, %d, want %d", little, got, err, test.signed)
+		}
+
+		// The encoders write exactly 6 bytes, so a guard byte after them is kept
+		buffer := []byte{0, 0, 0, 0, 0, 0, 0xA5}
+		checkEncoded(t, "Uint48ToBytes", buffer, Uint48ToBytes(test.unsigned, buffer), test.encoded)
+		checkEncoded(t, "Uint48ToBytesLE", buffer, Uint48ToBytesLE(test.unsigned, buffer), little)
+		checkEncoded(t, "Int48ToBytes", buffer, Int48ToBytes(test.signed, buffer), test.encoded)
+		checkEncoded(t, "Int48ToBytesLE", buffer, Int48ToBytesLE(test.signed, buffer), little)
+	}
+}
+
+// TestInt48HighBits checks that the bits above 48 are dropped on encoding
+func TestInt48HighBits(t *testing.T) {
+	const value = 0xABCD123456789ABC
+	buffer := []byte{0, 0, 0, 0, 0, 0, 0xA5}
+	big, little := []byte{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}, []byte{0xBC, 0x9A, 0x78, 0x56, 0x34, 0x12}
+	checkEncoded(t, "Uint48ToBytes", buffer, Uint48ToBytes(value, buffer), big)
+	checkEncoded(t, "Uint48ToBytesLE", buffer, Uint48ToBytesLE(value, buffer), little)
+	ones := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	checkEncoded(t, "Int48ToBytes(MaxInt64)", buffer, Int48ToBytes(math.MaxInt64, buffer), ones)
+	checkEncoded(t, "Int48ToBytes(MinInt64)", buffer, Int48ToBytes(math.MinInt64, buffer), []byte{0, 0, 0, 0, 0, 0})
+	checkEncoded(t, "Int48ToBytesLE(1<<47)", buffer, Int48ToBytesLE(1<<47, buffer), []byte{0, 0, 0, 0, 0, 0x80})
+}
+
+// TestInt48ShortBuffer checks that buffers shorter than 6 bytes are rejected
+func TestInt48ShortBuffer(t *testing.T) {
+	short := make([]byte, 5)
+	for name, err := range map[string]tinygoerrors.ErrorCode{
+		"Uint48ToBytes":   Uint48ToBytes(1, short),
+		"Uint48ToBytesLE": Uint48ToBytesLE(1, short),
+		"Int48ToBytes":    Int48ToBytes(1, short),
+		"Int48ToBytesLE":  Int48ToBytesLE(1, short),
+	} {
+		if err != ErrorCodeBuffersInvalidBufferSize {
+			t.Errorf("%s with a 5 byte buffer returned error %d", name, err)
+		}
+	}
+	if _, err := BytesToUint48(short); err != ErrorCodeBuffersInvalidBufferSize {
+		t.Errorf("BytesToUint48 with 5 bytes returned error %d", err)
+	}
+	if _, err := BytesToInt48LE(short); err != ErrorCodeBuffersInvalidBufferSize {
+		t.Errorf("BytesToInt48LE with 5 bytes returned error %d", err)
+	}
+}
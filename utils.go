@@ -1,9 +1,6 @@
 package tinygo_buffers
 
 import (
-	"encoding/binary"
-	"math"
-
 	tinygoerrors "github.com/ralvarezdev/tinygo-errors"
 )
 
@@ -36,13 +33,9 @@ func UintToHexIndex(value uint64, size int, pos int) int {
 //
 // A byte slice representing the hexadecimal representation of the uint8 value.
 func Uint8ToHex(value uint8) []byte {
-	for c := range UintToHexBuffer {
-		index := UintToHexIndex(uint64(value), 8, c)
-		if index >= 0 {
-			UintToHexBuffer[c] = ASCIIHexDigits[index]
-		}
-	}
-	return UintToHexBuffer[:2]
+	result := defaultFormatter.Uint8ToHex(value)
+	copy(UintToHexBuffer[:], result)
+	return result
 }
 
 // Uint16ToHex converts an uint16 value to its hexadecimal representation
@@ -55,13 +48,9 @@ func Uint8ToHex(value uint8) []byte {
 //
 // A byte slice representing the hexadecimal representation of the uint16 value.
 func Uint16ToHex(value uint16) []byte {
-	for c := range UintToHexBuffer {
-		index := UintToHexIndex(uint64(value), 16, c)
-		if index >= 0 {
-			UintToHexBuffer[c] = ASCIIHexDigits[index]
-		}
-	}
-	return UintToHexBuffer[:4]
+	result := defaultFormatter.Uint16ToHex(value)
+	copy(UintToHexBuffer[:], result)
+	return result
 }
 
 // Uint32ToHex converts an uint32 value to its hexadecimal representation
@@ -74,13 +63,9 @@ func Uint16ToHex(value uint16) []byte {
 //
 // A byte slice representing the hexadecimal representation of the uint32 value.
 func Uint32ToHex(value uint32) []byte {
-	for c := range UintToHexBuffer {
-		index := UintToHexIndex(uint64(value), 32, c)
-		if index >= 0 {
-			UintToHexBuffer[c] = ASCIIHexDigits[index]
-		}
-	}
-	return UintToHexBuffer[:8]
+	result := defaultFormatter.Uint32ToHex(value)
+	copy(UintToHexBuffer[:], result)
+	return result
 }
 
 // Uint64ToHex converts an uint64 value to its hexadecimal representation
@@ -93,38 +78,24 @@ func Uint32ToHex(value uint32) []byte {
 //
 // A byte slice representing the hexadecimal representation of the uint64 value.
 func Uint64ToHex(value uint64) []byte {
-	for c := range UintToHexBuffer {
-		index := UintToHexIndex(value, 64, c)
-		if index >= 0 {
-			UintToHexBuffer[c] = ASCIIHexDigits[index]
-		}
-	}
-	return UintToHexBuffer[:16]
+	result := defaultFormatter.Uint64ToHex(value)
+	copy(UintToHexBuffer[:], result)
+	return result
 }
 
-// UintToDecimal converts an uint8 value to its decimal representation
+// UintToDecimal converts an uint64 value to its decimal representation
 //
 // Parameters:
 //
-//	value: The uint8 value to convert.
+//	value: The uint64 value to convert.
 //
 // Returns:
 //
-// A byte slice representing the decimal representation of the uint8 value.
+// A byte slice representing the decimal representation of the uint64 value.
 func UintToDecimal(value uint64) []byte {
-	// Fill buffer from the end
-	i := len(UintToDecimalBuffer)
-	v := value
-	if v == 0 {
-		i--
-		UintToDecimalBuffer[i] = ASCIIDecimalDigits[0]
-	}
-	for v > 0 && i > 0 {
-		i--
-		UintToDecimalBuffer[i] = ASCIIDecimalDigits[v%10]
-		v /= 10
-	}
-	return UintToDecimalBuffer[i:]
+	result := defaultFormatter.UintToDecimal(value)
+	copy(UintToDecimalBuffer[len(UintToDecimalBuffer)-len(result):], result)
+	return result
 }
 
 // IntToDecimal converts an int64 value to its decimal representation
@@ -137,26 +108,9 @@ func UintToDecimal(value uint64) []byte {
 //
 // A byte slice representing the decimal representation of the int64 value, including a minus sign if negative.
 func IntToDecimal(value int64) []byte {
-	i := len(IntToDecimalBuffer)
-	negative := value < 0
-	v := value
-	if negative {
-		v = -v
-	}
-	if v == 0 {
-		i--
-		IntToDecimalBuffer[i] = ASCIIDecimalDigits[0]
-	}
-	for v > 0 && i > 0 {
-		i--
-		IntToDecimalBuffer[i] = ASCIIDecimalDigits[v%10]
-		v /= 10
-	}
-	if negative && i > 0 {
-		i--
-		IntToDecimalBuffer[i] = '-'
-	}
-	return IntToDecimalBuffer[i:]
+	result := defaultFormatter.IntToDecimal(value)
+	copy(IntToDecimalBuffer[len(IntToDecimalBuffer)-len(result):], result)
+	return result
 }
 
 // UintToDecimalFixed converts an uint value to its decimal representation with fixed width
@@ -170,21 +124,9 @@ func IntToDecimal(value int64) []byte {
 //
 // A byte slice representing the decimal representation of the uint value with leading zeros if necessary.
 func UintToDecimalFixed(value uint64, width int) []byte {
-	buffer := UintToDecimal(uint64(value))
-	pad := width - len(buffer)
-
-	// Check if padding is needed
-	if pad <= 0 {
-		return buffer
-	}
-
-	// Move existing digits to the right
-	copy(UintToDecimalBuffer[pad:], buffer)
-	// Prepend leading zeros
-	for i := 0; i < pad; i++ {
-		UintToDecimalBuffer[i] = ASCIIDecimalDigits[0]
-	}
-	return UintToDecimalBuffer[:width]
+	result := defaultFormatter.UintToDecimalFixed(value, width)
+	copy(UintToDecimalBuffer[:], result)
+	return result
 }
 
 // Float64ToDecimal converts a float64 value to its decimal representation with specified precision
@@ -196,40 +138,37 @@ func UintToDecimalFixed(value uint64, width int) []byte {
 //
 // Returns:
 //
-// The number of bytes written to the buffer and an error code indicating success or failure
+// A byte slice representing the decimal representation of the float64 value and an error code indicating success or
+// failure.
 func Float64ToDecimal(value float64, precision int) (
 	[]byte,
 	tinygoerrors.ErrorCode,
 ) {
-	// Get the integer and fractional parts
-	intPart := int64(value)
-	fracPart := math.Abs(value - float64(intPart))
-	idx := 0
-
-	// Convert integer part
-	intBuf := IntToDecimal(intPart)
-	copy(Float64ToDecimalBuffer[idx:], intBuf)
-	idx += len(intBuf)
-
-	// Add dot
-	Float64ToDecimalBuffer[idx] = '.'
-	idx++
-
-	// Check precision limit
-	if len(Float64ToDecimalBuffer)-idx < precision {
-		return nil, ErrorCodeBuffersTooMuchPrecisionDigitsForFloat64
-	}
-
-	// Convert fractional part
-	for i := 0; i < precision; i++ {
-		fracPart *= 10
-		digit := int(math.Abs(fracPart))
-		Float64ToDecimalBuffer[idx] = byte('0' + digit)
-		idx++
-		fracPart -= float64(digit)
-	}
+	result, err := defaultFormatter.Float64ToDecimal(value, precision)
+	copy(Float64ToDecimalBuffer[:], result)
+	return result, err
+}
 
-	return Float64ToDecimalBuffer[:idx], tinygoerrors.ErrorCodeNil
+// Float64ToDecimalRounded converts a float64 value to its decimal representation with specified precision and rounding
+// mode
+//
+// Parameters:
+//
+//	value: The float64 value to convert.
+//	precision: The number of digits after the decimal point.
+//	mode: The rounding mode applied to the digits beyond the precision.
+//
+// Returns:
+//
+// A byte slice representing the decimal representation of the float64 value and an error code indicating success or
+// failure.
+func Float64ToDecimalRounded(value float64, precision int, mode RoundingMode) (
+	[]byte,
+	tinygoerrors.ErrorCode,
+) {
+	result, err := defaultFormatter.Float64ToDecimalRounded(value, precision, mode)
+	copy(Float64ToDecimalBuffer[:], result)
+	return result, err
 }
 
 // Uint16ToBytes converts an uint16 value to an array of 2 bytes in big-endian order, storing the result in the provided buffer
@@ -243,12 +182,7 @@ func Float64ToDecimal(value float64, precision int) (
 //
 // An error code indicating success or failure.
 func Uint16ToBytes(value uint16, buffer []byte) tinygoerrors.ErrorCode {
-	// Ensure the buffer has at least 2 bytes
-	if len(buffer) < 2 {
-		return ErrorCodeBuffersInvalidBufferSize
-	}
-	binary.BigEndian.PutUint16(buffer, value)
-	return tinygoerrors.ErrorCodeNil
+	return PutBE(buffer, value)
 }
 
 // Int16ToBytes converts an int16 value to an array of 2 bytes in big-endian order, storing the result in the provided buffer
@@ -262,7 +196,7 @@ func Uint16ToBytes(value uint16, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Int16ToBytes(value int16, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint16ToBytes(uint16(value), buffer)
+	return PutBE(buffer, value)
 }
 
 // Uint32ToBytes converts an uint32 value to an array of 4 bytes in big-endian order, storing the result in the provided buffer
@@ -276,12 +210,7 @@ func Int16ToBytes(value int16, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Uint32ToBytes(value uint32, buffer []byte) tinygoerrors.ErrorCode {
-	// Ensure the buffer has at least 4 bytes
-	if len(buffer) < 4 {
-		return ErrorCodeBuffersInvalidBufferSize
-	}
-	binary.BigEndian.PutUint32(buffer, value)
-	return tinygoerrors.ErrorCodeNil
+	return PutBE(buffer, value)
 }
 
 // Int32ToBytes converts an int32 value to an array of 4 bytes in big-endian order, storing the result in the provided buffer
@@ -295,7 +224,7 @@ func Uint32ToBytes(value uint32, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Int32ToBytes(value int32, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint32ToBytes(uint32(value), buffer)
+	return PutBE(buffer, value)
 }
 
 // Uint64ToBytes converts an uint64 value to an array of 8 bytes in big-endian order, storing the result in the provided buffer
@@ -309,12 +238,7 @@ func Int32ToBytes(value int32, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Uint64ToBytes(value uint64, buffer []byte) tinygoerrors.ErrorCode {
-	// Ensure the buffer has at least 8 bytes
-	if len(buffer) < 8 {
-		return ErrorCodeBuffersInvalidBufferSize
-	}
-	binary.BigEndian.PutUint64(buffer, value)
-	return tinygoerrors.ErrorCodeNil
+	return PutBE(buffer, value)
 }
 
 // Int64ToBytes converts an int64 value to an array of 8 bytes in big-endian order, storing the result in the provided buffer
@@ -328,7 +252,7 @@ func Uint64ToBytes(value uint64, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Int64ToBytes(value int64, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint64ToBytes(uint64(value), buffer)
+	return PutBE(buffer, value)
 }
 
 // Float32ToBytes converts a float32 value to an array of 4 bytes in big-endian order, storing the result in the provided buffer
@@ -342,7 +266,7 @@ func Int64ToBytes(value int64, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Float32ToBytes(value float32, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint32ToBytes(math.Float32bits(value), buffer)
+	return PutBE(buffer, value)
 }
 
 // Float64ToBytes converts a float64 value to an array of 8 bytes in big-endian order, storing the result in the provided buffer
@@ -356,7 +280,7 @@ func Float32ToBytes(value float32, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Float64ToBytes(value float64, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint64ToBytes(math.Float64bits(value), buffer)
+	return PutBE(buffer, value)
 }
 
 // Uint16ToBytesLE converts an uint16 value to an array of 2 bytes in little-endian order, storing the result in the provided buffer
@@ -370,12 +294,7 @@ func Float64ToBytes(value float64, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Uint16ToBytesLE(value uint16, buffer []byte) tinygoerrors.ErrorCode {
-	// Ensure the buffer has at least 2 bytes
-	if len(buffer) < 2 {
-		return ErrorCodeBuffersInvalidBufferSize
-	}
-	binary.LittleEndian.PutUint16(buffer, value)
-	return tinygoerrors.ErrorCodeNil
+	return PutLE(buffer, value)
 }
 
 // Int16ToBytesLE converts an int16 value to an array of 2 bytes in little-endian order, storing the result in the provided buffer
@@ -389,7 +308,7 @@ func Uint16ToBytesLE(value uint16, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Int16ToBytesLE(value int16, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint16ToBytesLE(uint16(value), buffer)
+	return PutLE(buffer, value)
 }
 
 // Uint32ToBytesLE converts an uint32 value to an array of 4 bytes in little-endian order, storing the result in the provided buffer
@@ -403,12 +322,7 @@ func Int16ToBytesLE(value int16, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Uint32ToBytesLE(value uint32, buffer []byte) tinygoerrors.ErrorCode {
-	// Ensure the buffer has at least 4 bytes
-	if len(buffer) < 4 {
-		return ErrorCodeBuffersInvalidBufferSize
-	}
-	binary.LittleEndian.PutUint32(buffer, value)
-	return tinygoerrors.ErrorCodeNil
+	return PutLE(buffer, value)
 }
 
 // Int32ToBytesLE converts an int32 value to an array of 4 bytes in little-endian order, storing the result in the provided buffer
@@ -422,7 +336,7 @@ func Uint32ToBytesLE(value uint32, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Int32ToBytesLE(value int32, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint32ToBytesLE(uint32(value), buffer)
+	return PutLE(buffer, value)
 }
 
 // Uint64ToBytesLE converts an uint64 value to an array of 8 bytes in little-endian order, storing the result in the provided buffer
@@ -436,12 +350,7 @@ func Int32ToBytesLE(value int32, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Uint64ToBytesLE(value uint64, buffer []byte) tinygoerrors.ErrorCode {
-	// Ensure the buffer has at least 8 bytes
-	if len(buffer) < 8 {
-		return ErrorCodeBuffersInvalidBufferSize
-	}
-	binary.LittleEndian.PutUint64(buffer, value)
-	return tinygoerrors.ErrorCodeNil
+	return PutLE(buffer, value)
 }
 
 // Int64ToBytesLE converts an int64 value to an array of 8 bytes in little-endian order, storing the result in the provided buffer
@@ -455,7 +364,7 @@ func Uint64ToBytesLE(value uint64, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Int64ToBytesLE(value int64, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint64ToBytesLE(uint64(value), buffer)
+	return PutLE(buffer, value)
 }
 
 // Float32ToBytesLE converts a float32 value to an array of 4 bytes in little-endian order, storing the result in the provided buffer
@@ -469,7 +378,7 @@ func Int64ToBytesLE(value int64, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Float32ToBytesLE(value float32, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint32ToBytesLE(math.Float32bits(value), buffer)
+	return PutLE(buffer, value)
 }
 
 // Float64ToBytesLE converts a float64 value to an array of 8 bytes in little-endian order, storing the result in the provided buffer
@@ -483,7 +392,7 @@ func Float32ToBytesLE(value float32, buffer []byte) tinygoerrors.ErrorCode {
 //
 // An error code indicating success or failure.
 func Float64ToBytesLE(value float64, buffer []byte) tinygoerrors.ErrorCode {
-	return Uint64ToBytesLE(math.Float64bits(value), buffer)
+	return PutLE(buffer, value)
 }
 
 // BytesToUint16 converts a byte slice to an uint16 value
@@ -496,10 +405,7 @@ func Float64ToBytesLE(value float64, buffer []byte) tinygoerrors.ErrorCode {
 //
 // The uint16 value represented by the first 2 bytes of the input slice, or an error code if the input is invalid.
 func BytesToUint16(data []byte) (uint16, tinygoerrors.ErrorCode) {
-	if len(data) < 2 {
-		return 0, ErrorCodeBuffersInvalidBufferSize
-	}
-	return (uint16(data[0]) << 8) | uint16(data[1]), tinygoerrors.ErrorCodeNil
+	return GetBE[uint16](data)
 }
 
 // BytesToInt16 converts a byte slice to an int16 value
@@ -512,11 +418,7 @@ func BytesToUint16(data []byte) (uint16, tinygoerrors.ErrorCode) {
 //
 // The int16 value represented by the first 2 bytes of the input slice, or an error code if the input is invalid.
 func BytesToInt16(data []byte) (int16, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint16(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return int16(u), tinygoerrors.ErrorCodeNil
+	return GetBE[int16](data)
 }
 
 // BytesToUint32 converts a byte slice to an uint32 value
@@ -529,10 +431,7 @@ func BytesToInt16(data []byte) (int16, tinygoerrors.ErrorCode) {
 //
 // The uint32 value represented by the first 4 bytes of the input slice, or an error code if the input is invalid.
 func BytesToUint32(data []byte) (uint32, tinygoerrors.ErrorCode) {
-	if len(data) < 4 {
-		return 0, ErrorCodeBuffersInvalidBufferSize
-	}
-	return (uint32(data[0]) << 24) | (uint32(data[1]) << 16) | (uint32(data[2]) << 8) | uint32(data[3]), tinygoerrors.ErrorCodeNil
+	return GetBE[uint32](data)
 }
 
 // BytesToInt32 converts a byte slice to an int32 value
@@ -545,11 +444,7 @@ func BytesToUint32(data []byte) (uint32, tinygoerrors.ErrorCode) {
 //
 // The int32 value represented by the first 4 bytes of the input slice, or an error code if the input is invalid.
 func BytesToInt32(data []byte) (int32, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint32(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return int32(u), tinygoerrors.ErrorCodeNil
+	return GetBE[int32](data)
 }
 
 // BytesToUint64 converts a byte slice to an uint64 value
@@ -562,11 +457,7 @@ func BytesToInt32(data []byte) (int32, tinygoerrors.ErrorCode) {
 //
 // The uint64 value represented by the first 8 bytes of the input slice, or an error code if the input is invalid.
 func BytesToUint64(data []byte) (uint64, tinygoerrors.ErrorCode) {
-	if len(data) < 8 {
-		return 0, ErrorCodeBuffersInvalidBufferSize
-	}
-	return (uint64(data[0]) << 56) | (uint64(data[1]) << 48) | (uint64(data[2]) << 40) | (uint64(data[3]) << 32) |
-		(uint64(data[4]) << 24) | (uint64(data[5]) << 16) | (uint64(data[6]) << 8) | uint64(data[7]), tinygoerrors.ErrorCodeNil
+	return GetBE[uint64](data)
 }
 
 // BytesToInt64 converts a byte slice to an int64 value
@@ -579,11 +470,7 @@ func BytesToUint64(data []byte) (uint64, tinygoerrors.ErrorCode) {
 //
 // The int64 value represented by the first 8 bytes of the input slice, or an error code if the input is invalid.
 func BytesToInt64(data []byte) (int64, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint64(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return int64(u), tinygoerrors.ErrorCodeNil
+	return GetBE[int64](data)
 }
 
 // BytesToFloat32 converts a byte slice to a float32 value
@@ -596,11 +483,7 @@ func BytesToInt64(data []byte) (int64, tinygoerrors.ErrorCode) {
 //
 // The float32 value represented by the first 4 bytes of the input slice, or an error code if the input is invalid.
 func BytesToFloat32(data []byte) (float32, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint32(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return math.Float32frombits(u), tinygoerrors.ErrorCodeNil
+	return GetBE[float32](data)
 }
 
 // BytesToFloat64 converts a byte slice to a float64 value
@@ -613,11 +496,7 @@ func BytesToFloat32(data []byte) (float32, tinygoerrors.ErrorCode) {
 //
 // The float64 value represented by the first 8 bytes of the input slice, or an error code if the input is invalid.
 func BytesToFloat64(data []byte) (float64, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint64(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return math.Float64frombits(u), tinygoerrors.ErrorCodeNil
+	return GetBE[float64](data)
 }
 
 // BytesToUint16LE converts a byte slice to an uint16 value in little-endian order
@@ -630,10 +509,7 @@ func BytesToFloat64(data []byte) (float64, tinygoerrors.ErrorCode) {
 //
 // The uint16 value represented by the first 2 bytes of the input slice in little-endian order, or an error code if the input is invalid.
 func BytesToUint16LE(data []byte) (uint16, tinygoerrors.ErrorCode) {
-	if len(data) < 2 {
-		return 0, ErrorCodeBuffersInvalidBufferSize
-	}
-	return (uint16(data[1]) << 8) | uint16(data[0]), tinygoerrors.ErrorCodeNil
+	return GetLE[uint16](data)
 }
 
 // BytesToInt16LE converts a byte slice to an int16 value in little-endian order
@@ -646,11 +522,7 @@ func BytesToUint16LE(data []byte) (uint16, tinygoerrors.ErrorCode) {
 //
 // The int16 value represented by the first 2 bytes of the input slice in little-endian order, or an error code if the input is invalid.
 func BytesToInt16LE(data []byte) (int16, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint16LE(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return int16(u), tinygoerrors.ErrorCodeNil
+	return GetLE[int16](data)
 }
 
 // BytesToUint32LE converts a byte slice to an uint32 value in little-endian order
@@ -663,10 +535,7 @@ func BytesToInt16LE(data []byte) (int16, tinygoerrors.ErrorCode) {
 //
 // The uint32 value represented by the first 4 bytes of the input slice in little-endian order, or an error code if the input is invalid.
 func BytesToUint32LE(data []byte) (uint32, tinygoerrors.ErrorCode) {
-	if len(data) < 4 {
-		return 0, ErrorCodeBuffersInvalidBufferSize
-	}
-	return (uint32(data[3]) << 24) | (uint32(data[2]) << 16) | (uint32(data[1]) << 8) | uint32(data[0]), tinygoerrors.ErrorCodeNil
+	return GetLE[uint32](data)
 }
 
 // BytesToInt32LE converts a byte slice to an int32 value in little-endian order
@@ -679,11 +548,7 @@ func BytesToUint32LE(data []byte) (uint32, tinygoerrors.ErrorCode) {
 //
 // The int32 value represented by the first 4 bytes of the input slice in little-endian order, or an error code if the input is invalid.
 func BytesToInt32LE(data []byte) (int32, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint32LE(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return int32(u), tinygoerrors.ErrorCodeNil
+	return GetLE[int32](data)
 }
 
 // BytesToUint64LE converts a byte slice to an uint64 value in little-endian order
@@ -696,11 +561,7 @@ func BytesToInt32LE(data []byte) (int32, tinygoerrors.ErrorCode) {
 //
 // The uint64 value represented by the first 8 bytes of the input slice in little-endian order, or an error code if the input is invalid.
 func BytesToUint64LE(data []byte) (uint64, tinygoerrors.ErrorCode) {
-	if len(data) < 8 {
-		return 0, ErrorCodeBuffersInvalidBufferSize
-	}
-	return (uint64(data[7]) << 56) | (uint64(data[6]) << 48) | (uint64(data[5]) << 40) | (uint64(data[4]) << 32) |
-		(uint64(data[3]) << 24) | (uint64(data[2]) << 16) | (uint64(data[1]) << 8) | uint64(data[0]), tinygoerrors.ErrorCodeNil
+	return GetLE[uint64](data)
 }
 
 // BytesToInt64LE converts a byte slice to an int64 value in little-endian order
@@ -713,11 +574,7 @@ func BytesToUint64LE(data []byte) (uint64, tinygoerrors.ErrorCode) {
 //
 // The int64 value represented by the first 8 bytes of the input slice in little-endian order, or an error code if the input is invalid.
 func BytesToInt64LE(data []byte) (int64, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint64LE(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return int64(u), tinygoerrors.ErrorCodeNil
+	return GetLE[int64](data)
 }
 
 // BytesToFloat32LE converts a byte slice to a float32 value in little-endian order
@@ -730,11 +587,7 @@ func BytesToInt64LE(data []byte) (int64, tinygoerrors.ErrorCode) {
 //
 // The float32 value represented by the first 4 bytes of the input slice in little-endian order, or an error code if the input is invalid.
 func BytesToFloat32LE(data []byte) (float32, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint32LE(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return math.Float32frombits(u), tinygoerrors.ErrorCodeNil
+	return GetLE[float32](data)
 }
 
 // BytesToFloat64LE converts a byte slice to a float64 value in little-endian order
@@ -747,9 +600,5 @@ func BytesToFloat32LE(data []byte) (float32, tinygoerrors.ErrorCode) {
 //
 // The float64 value represented by the first 8 bytes of the input slice in little-endian order, or an error code if the input is invalid.
 func BytesToFloat64LE(data []byte) (float64, tinygoerrors.ErrorCode) {
-	u, err := BytesToUint64LE(data)
-	if err != tinygoerrors.ErrorCodeNil {
-		return 0, err
-	}
-	return math.Float64frombits(u), tinygoerrors.ErrorCodeNil
+	return GetLE[float64](data)
 }